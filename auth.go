@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Autenticação das rotas. Toda rota é registrada em main como publica(...),
+// protegida(...) ou protegidaEscopo(...). As protegidas validam o token,
+// confirmam que o usuário ainda existe e colocam um Usuario no contexto da
+// requisição, que os handlers leem com usuarioDoContexto. Chaves de API (ver
+// chavesapi.go) só passam por protegidaEscopo.
+
+const duracaoCacheUsuario = 5 * time.Minute
+
+type Usuario struct {
+	UUID       string
+	Familia    string
+	Papel      Papel
+	Verificado bool
+	// contas criadas por login institucional começam sem CPF
+	PerfilCompleto bool
+	// ChaveAPI é o id da chave quando quem chama é uma integração; nesse
+	// caso os outros campos ficam vazios.
+	ChaveAPI string
+}
+
+type chaveContexto int
+
+const chaveUsuario chaveContexto = iota
+
+func chaveCacheUsuario(userID string) string {
+	return fmt.Sprintf("user:%s:cache", userID)
+}
+
+// publica não faz nada; serve para deixar explícito em main que a rota não
+// exige login.
+func publica(next http.HandlerFunc) http.HandlerFunc {
+	return next
+}
+
+// protegida exige um token válido e, se papéis forem informados, que o
+// usuário tenha um deles.
+func protegida(next http.HandlerFunc, permitidos ...Papel) http.HandlerFunc {
+	return protegidaEscopo(next, "", permitidos...)
+}
+
+// protegidaEscopo é como protegida, mas também aceita chaves de API que
+// tenham o escopo informado. Com escopo vazio, chaves de API são recusadas.
+func protegidaEscopo(next http.HandlerFunc, escopo Escopo, permitidos ...Papel) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if chave, ok := strings.CutPrefix(r.Header.Get("Authorization"), "ApiKey "); ok {
+			api, err := autenticarChaveAPI(chave)
+			if err == errChaveAPIInvalida {
+				enviarErrorJson(w, "Chave de API inválida ou revogada", 401)
+				return
+			} else if err != nil {
+				logger.Println("[e] Erro ao verificar chave de API:", err)
+				enviarErrorJson(w, "Algo não deu certo", 500)
+				return
+			}
+
+			if escopo == "" {
+				enviarErroCodigo(w, codigoSemPermissao, "Esta rota não aceita chaves de API", http.StatusForbidden)
+				return
+			}
+			if !slices.Contains(api.Escopos, escopo) {
+				enviarErroCodigo(w, codigoSemPermissao, fmt.Sprintf("A chave de API não tem o escopo %v", escopo), http.StatusForbidden)
+				return
+			}
+
+			next(w, r.WithContext(context.WithValue(r.Context(), chaveUsuario, Usuario{ChaveAPI: api.ID})))
+			return
+		}
+
+		uid := getUserUUID(r)
+		if uid.Status != 200 {
+			enviarErrorJson(w, uid.Message, uid.Status)
+			return
+		}
+
+		if len(permitidos) > 0 && !slices.Contains(permitidos, uid.Papel) {
+			enviarErroCodigo(w, codigoSemPermissao, "Você não tem permissão para acessar esta rota", http.StatusForbidden)
+			return
+		}
+
+		usuario, err := carregarUsuario(uid)
+		if err == sql.ErrNoRows {
+			enviarErrorJson(w, "O usuário não existe mais", 401)
+			return
+		} else if err != nil {
+			logger.Println("[e] Erro ao buscar usuário:", err)
+			enviarErrorJson(w, "Algo não deu certo", 500)
+			return
+		}
+
+		next(w, r.WithContext(context.WithValue(r.Context(), chaveUsuario, usuario)))
+	}
+}
+
+// contaVerificada deve vir dentro de protegida; barra quem ainda não
+// confirmou o email.
+func contaVerificada(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !usuarioDoContexto(r).Verificado {
+			enviarErroCodigo(w, codigoEmailNaoVerificado, "Confirme seu email antes de responder perguntas", 403)
+			return
+		}
+		next(w, r)
+	}
+}
+
+// perfilCompleto deve vir dentro de protegida; barra quem entrou por login
+// institucional e ainda não informou o CPF.
+func perfilCompleto(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !usuarioDoContexto(r).PerfilCompleto {
+			enviarErroCodigo(w, codigoPerfilIncompleto, "Complete seu perfil antes de responder perguntas", 403)
+			return
+		}
+		next(w, r)
+	}
+}
+
+func usuarioDoContexto(r *http.Request) Usuario {
+	usuario, _ := r.Context().Value(chaveUsuario).(Usuario)
+	return usuario
+}
+
+// carregarUsuario completa os dados do token com os do banco, guardando o
+// resultado no Redis por alguns minutos.
+func carregarUsuario(uid UserUUID) (Usuario, error) {
+	usuario := Usuario{UUID: uid.UUID, Familia: uid.Familia, Papel: uid.Papel}
+
+	cache, err := rdb.HGetAll(ctx, chaveCacheUsuario(uid.UUID)).Result()
+	if err == nil && cache["verificado"] != "" && cache["perfil_completo"] != "" {
+		usuario.Verificado, _ = strconv.ParseBool(cache["verificado"])
+		usuario.PerfilCompleto, _ = strconv.ParseBool(cache["perfil_completo"])
+		return usuario, nil
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		return Usuario{}, err
+	}
+	defer conn.Close()
+
+	err = conn.QueryRow("SELECT verificado, cpf_cifrado IS NOT NULL FROM users WHERE id = ?", uid.UUID).Scan(&usuario.Verificado, &usuario.PerfilCompleto)
+	if err != nil {
+		return Usuario{}, err
+	}
+
+	pipe := rdb.TxPipeline()
+	pipe.HSet(ctx, chaveCacheUsuario(uid.UUID),
+		"verificado", strconv.FormatBool(usuario.Verificado),
+		"perfil_completo", strconv.FormatBool(usuario.PerfilCompleto))
+	pipe.Expire(ctx, chaveCacheUsuario(uid.UUID), duracaoCacheUsuario)
+	if _, err := pipe.Exec(ctx); err != nil {
+		logger.Printf("[w] falha ao guardar cache de %v: %v\n", uid.UUID, err)
+	}
+
+	return usuario, nil
+}
+
+// invalidarCacheUsuario deve ser chamada sempre que algo guardado no cache
+// mudar no banco.
+func invalidarCacheUsuario(userID string) {
+	if err := rdb.Del(ctx, chaveCacheUsuario(userID)).Err(); err != nil {
+		logger.Printf("[w] falha ao limpar cache de %v: %v\n", userID, err)
+	}
+}
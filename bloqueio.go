@@ -0,0 +1,129 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Proteção contra força bruta no /login/auth. Falhas são contadas por conta
+// (email) e por IP dentro de uma janela. A partir de algumas falhas a conta
+// precisa esperar cada vez mais entre tentativas e, no limite, fica bloqueada
+// por um tempo. Tudo isso é checado antes do bcrypt.
+
+const (
+	janelaFalhas       = 15 * time.Minute
+	duracaoBloqueio    = 15 * time.Minute
+	falhasAntesEspera  = 3
+	maxFalhasConta     = 8
+	maxFalhasIP        = 30
+	esperaMaximaFalhas = 30 * time.Second
+)
+
+func chaveFalhas(tipo, valor string) string {
+	return fmt.Sprintf("login:falhas:%s:%s", tipo, valor)
+}
+
+func chaveBloqueio(tipo, valor string) string {
+	return fmt.Sprintf("login:bloqueio:%s:%s", tipo, valor)
+}
+
+func chaveEspera(tipo, valor string) string {
+	return fmt.Sprintf("login:espera:%s:%s", tipo, valor)
+}
+
+// tempoBloqueioLogin devolve quanto falta para a conta/IP poder tentar de
+// novo, ou zero se estiver liberado.
+func tempoBloqueioLogin(email, ip string) (time.Duration, error) {
+	email = strings.ToLower(email)
+
+	pipe := rdb.Pipeline()
+	cmds := []*redis.DurationCmd{
+		pipe.PTTL(ctx, chaveBloqueio("conta", email)),
+		pipe.PTTL(ctx, chaveEspera("conta", email)),
+		pipe.PTTL(ctx, chaveBloqueio("ip", ip)),
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return 0, err
+	}
+
+	var maior time.Duration
+	for _, cmd := range cmds {
+		// PTTL devolve negativo se a chave não existe
+		if ttl := cmd.Val(); ttl > maior {
+			maior = ttl
+		}
+	}
+	return maior, nil
+}
+
+// registrarFalhaLogin conta a falha e aplica espera/bloqueio quando preciso.
+// userID pode ser vazio quando o email não existe.
+func registrarFalhaLogin(email, ip, userID string) {
+	email = strings.ToLower(email)
+
+	falhasConta, err := incrementarFalhas("conta", email)
+	if err != nil {
+		logger.Println("[e] Erro ao contar falha de login:", err)
+		return
+	}
+	falhasIP, err := incrementarFalhas("ip", ip)
+	if err != nil {
+		logger.Println("[e] Erro ao contar falha de login:", err)
+		return
+	}
+
+	registrarEvento(EventoSeguranca{UserID: userID, Email: email, IP: ip, Tipo: eventoLoginFalhou,
+		Detalhe: fmt.Sprintf("%v falhas da conta e %v do IP nos últimos %v", falhasConta, falhasIP, janelaFalhas)})
+
+	if falhasConta >= maxFalhasConta {
+		rdb.Set(ctx, chaveBloqueio("conta", email), 1, duracaoBloqueio)
+		rdb.Del(ctx, chaveFalhas("conta", email))
+		registrarEvento(EventoSeguranca{UserID: userID, Email: email, IP: ip, Tipo: eventoContaBloqueada,
+			Detalhe: fmt.Sprintf("%v senhas erradas seguidas, bloqueada por %v", falhasConta, duracaoBloqueio)})
+	} else if falhasConta >= falhasAntesEspera {
+		// 1s, 2s, 4s, ... até esperaMaximaFalhas
+		espera := time.Duration(math.Pow(2, float64(falhasConta-falhasAntesEspera))) * time.Second
+		rdb.Set(ctx, chaveEspera("conta", email), 1, min(espera, esperaMaximaFalhas))
+	}
+
+	if falhasIP >= maxFalhasIP {
+		rdb.Set(ctx, chaveBloqueio("ip", ip), 1, duracaoBloqueio)
+		rdb.Del(ctx, chaveFalhas("ip", ip))
+		registrarEvento(EventoSeguranca{Email: email, IP: ip, Tipo: eventoIPBloqueado,
+			Detalhe: fmt.Sprintf("%v falhas de login vindas do IP, bloqueado por %v", falhasIP, duracaoBloqueio)})
+	}
+}
+
+func incrementarFalhas(tipo, valor string) (int64, error) {
+	chave := chaveFalhas(tipo, valor)
+
+	falhas, err := rdb.Incr(ctx, chave).Result()
+	if err != nil {
+		return 0, err
+	}
+	if falhas == 1 {
+		err = rdb.Expire(ctx, chave, janelaFalhas).Err()
+	}
+	return falhas, err
+}
+
+// limparFalhasLogin zera o contador da conta depois de um login certo. O do
+// IP continua, para não ajudar quem testa várias contas a partir dele.
+func limparFalhasLogin(email string) {
+	email = strings.ToLower(email)
+	if err := rdb.Del(ctx, chaveFalhas("conta", email), chaveEspera("conta", email)).Err(); err != nil {
+		logger.Println("[w] falha ao limpar falhas de login:", err)
+	}
+}
+
+func enviarBloqueado(w http.ResponseWriter, espera time.Duration) {
+	segundos := int(math.Ceil(espera.Seconds()))
+	w.Header().Set("Retry-After", strconv.Itoa(segundos))
+	enviarErroCodigo(w, codigoLoginBloqueado, fmt.Sprintf("Muitas tentativas de login, tente de novo em %v segundos", segundos), http.StatusTooManyRequests)
+}
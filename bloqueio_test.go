@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+// bloqueioDeTeste sobe o Redis em memória e aponta o MariaDB para uma porta
+// fechada, onde registrarEvento só falha e loga.
+func bloqueioDeTeste(t *testing.T) *miniredis.Miniredis {
+	t.Helper()
+	t.Setenv("mariadb", "teste:teste@tcp(127.0.0.1:1)/teste")
+	return redisDeTeste(t)
+}
+
+func TestTempoBloqueioLogin(t *testing.T) {
+	casos := []struct {
+		falhas int
+		espera time.Duration
+	}{
+		{0, 0},
+		{falhasAntesEspera - 1, 0},
+		{falhasAntesEspera, time.Second},
+		{falhasAntesEspera + 1, 2 * time.Second},
+		{falhasAntesEspera + 2, 4 * time.Second},
+		{maxFalhasConta - 1, 16 * time.Second},
+		{maxFalhasConta, duracaoBloqueio},
+	}
+
+	for _, c := range casos {
+		t.Run(fmt.Sprintf("%v falhas", c.falhas), func(t *testing.T) {
+			bloqueioDeTeste(t)
+			for range c.falhas {
+				registrarFalhaLogin("aluno@ufu.br", "10.0.0.1", "usuario-1")
+			}
+
+			espera, err := tempoBloqueioLogin("aluno@ufu.br", "10.0.0.1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if espera != c.espera {
+				t.Errorf("espera %v, esperava %v", espera, c.espera)
+			}
+		})
+	}
+}
+
+func TestBloqueioPorConta(t *testing.T) {
+	casos := []struct {
+		nome   string
+		email  string
+		ip     string
+		espera time.Duration
+	}{
+		{"mesma conta de outro IP", "aluno@ufu.br", "10.0.0.2", duracaoBloqueio},
+		{"email em maiúsculas", "Aluno@UFU.br", "10.0.0.1", duracaoBloqueio},
+		{"outra conta do mesmo IP", "outro@ufu.br", "10.0.0.1", 0},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			bloqueioDeTeste(t)
+			for range maxFalhasConta {
+				registrarFalhaLogin("aluno@ufu.br", "10.0.0.1", "usuario-1")
+			}
+
+			espera, err := tempoBloqueioLogin(c.email, c.ip)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if espera != c.espera {
+				t.Errorf("espera %v, esperava %v", espera, c.espera)
+			}
+		})
+	}
+}
+
+// Quem testa uma senha em muitas contas é barrado pelo IP.
+func TestBloqueioPorIP(t *testing.T) {
+	bloqueioDeTeste(t)
+	for i := range maxFalhasIP {
+		registrarFalhaLogin(fmt.Sprintf("conta%v@ufu.br", i), "10.0.0.1", "")
+	}
+
+	if espera, _ := tempoBloqueioLogin("nova@ufu.br", "10.0.0.1"); espera != duracaoBloqueio {
+		t.Errorf("conta nova no IP bloqueado: espera %v, esperava %v", espera, duracaoBloqueio)
+	}
+	if espera, _ := tempoBloqueioLogin("nova@ufu.br", "10.0.0.2"); espera != 0 {
+		t.Errorf("a mesma conta de outro IP: espera %v, esperava 0", espera)
+	}
+}
+
+func TestLimparFalhasLogin(t *testing.T) {
+	bloqueioDeTeste(t)
+	for range falhasAntesEspera + 1 {
+		registrarFalhaLogin("aluno@ufu.br", "10.0.0.1", "usuario-1")
+	}
+
+	limparFalhasLogin("Aluno@ufu.br")
+	if espera, _ := tempoBloqueioLogin("aluno@ufu.br", "10.0.0.1"); espera != 0 {
+		t.Errorf("espera %v depois do login certo, esperava 0", espera)
+	}
+
+	// a contagem da conta recomeça, a do IP continua
+	registrarFalhaLogin("aluno@ufu.br", "10.0.0.1", "usuario-1")
+	if n, _ := rdb.Get(ctx, chaveFalhas("conta", "aluno@ufu.br")).Int(); n != 1 {
+		t.Errorf("%v falhas da conta, esperava 1", n)
+	}
+	if n, _ := rdb.Get(ctx, chaveFalhas("ip", "10.0.0.1")).Int(); n != falhasAntesEspera+2 {
+		t.Errorf("%v falhas do IP, esperava %v", n, falhasAntesEspera+2)
+	}
+}
+
+// Falhas antigas saem da janela e não somam com as novas.
+func TestJanelaFalhas(t *testing.T) {
+	m := bloqueioDeTeste(t)
+
+	for range falhasAntesEspera - 1 {
+		registrarFalhaLogin("aluno@ufu.br", "10.0.0.1", "usuario-1")
+	}
+	m.FastForward(janelaFalhas + time.Second)
+	registrarFalhaLogin("aluno@ufu.br", "10.0.0.1", "usuario-1")
+
+	if espera, _ := tempoBloqueioLogin("aluno@ufu.br", "10.0.0.1"); espera != 0 {
+		t.Errorf("espera %v, esperava 0", espera)
+	}
+}
@@ -0,0 +1,368 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Listagem do banco de questões para os clientes montarem quizzes sem chutar
+// ids. A paginação é por cursor (o id da última questão da página, que o
+// cliente não precisa interpretar), então páginas não se repetem nem pulam
+// questões quando o banco muda entre uma requisição e outra.
+
+const (
+	limitePadraoListagem = 20
+	limiteMaximoListagem = 100
+	tamanhoMaximoBusca   = 200
+
+	// ids lidos por consulta ao procurar uma questão ainda não respondida
+	loteSorteio = 200
+)
+
+type ItemQuestao struct {
+	ID int `json:"id"`
+	Pergunta
+	Respondida bool `json:"respondida"`
+}
+
+type PaginaQuestoes struct {
+	Questoes      []ItemQuestao `json:"questoes"`
+	ProximoCursor string        `json:"proximo_cursor,omitempty"`
+}
+
+func codificarCursor(id int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(id)))
+}
+
+func decodificarCursor(cursor string) (int, bool) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, false
+	}
+	id, err := strconv.Atoi(string(b))
+	return id, err == nil && id > 0
+}
+
+// questoesRespondidas diz, para cada id, se ele está no set
+// user:{id}:feitas, sem trazer o set inteiro.
+func questoesRespondidas(userID string, ids []int) ([]bool, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	membros := make([]any, len(ids))
+	for i, id := range ids {
+		membros[i] = id
+	}
+	return rdb.SMIsMember(ctx, fmt.Sprintf("user:%s:feitas", userID), membros...).Result()
+}
+
+// filtrosQuestoes monta as condições comuns à listagem e ao sorteio
+// (?topico= e ?dificuldade=) sobre a tabela questoes com o apelido q. Devolve
+// a mensagem de erro, ou "".
+func filtrosQuestoes(r *http.Request) ([]string, []any, string) {
+	condicoes := []string{"q.removida_em IS NULL"}
+	var args []any
+
+	if slugs := topicosDaQuery(r); len(slugs) > 0 {
+		cond, a := filtroTopicos("q.id", slugs)
+		condicoes = append(condicoes, cond)
+		args = append(args, a...)
+	}
+
+	if v := strings.ToLower(r.URL.Query().Get("dificuldade")); v != "" {
+		if !slices.Contains(dificuldades, v) {
+			return nil, nil, "A dificuldade deve ser facil, media ou dificil"
+		}
+		condicoes = append(condicoes, "q.dificuldade = ?")
+		args = append(args, v)
+	}
+	return condicoes, args, ""
+}
+
+func listarQuestoes(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodGet {
+		w.WriteHeader(406)
+		return
+	}
+	usuario := usuarioDoContexto(r)
+	query := r.URL.Query()
+
+	limite := limitePadraoListagem
+	if v := query.Get("limite"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > limiteMaximoListagem {
+			enviarErrorJson(w, "limite deve ser um número de 1 a "+strconv.Itoa(limiteMaximoListagem), 400)
+			return
+		}
+		limite = n
+	}
+
+	condicoes, args, msg := filtrosQuestoes(r)
+	if msg != "" {
+		enviarErrorJson(w, msg, 400)
+		return
+	}
+
+	depois := 0
+	if v := query.Get("cursor"); v != "" {
+		id, ok := decodificarCursor(v)
+		if !ok {
+			enviarErrorJson(w, "Cursor inválido", 400)
+			return
+		}
+		depois = id
+	}
+
+	if v := strings.TrimSpace(query.Get("q")); v != "" {
+		if utf8.RuneCountInString(v) > tamanhoMaximoBusca {
+			enviarErrorJson(w, "Busca muito longa", 400)
+			return
+		}
+		// as respostas aceitas das questões de resposta curta ficam fora da busca
+		condicoes = append(condicoes, `(MATCH (q.pergunta) AGAINST (? IN NATURAL LANGUAGE MODE) OR (q.tipo <> 'texto' AND EXISTS (
+        SELECT 1 FROM alternativas a WHERE a.questao_id = q.id AND MATCH (a.texto) AGAINST (? IN NATURAL LANGUAGE MODE))))`)
+		args = append(args, v, v)
+	}
+
+	respondidas := query.Get("respondidas")
+	if respondidas != "" && respondidas != "true" && respondidas != "false" {
+		enviarErrorJson(w, "respondidas deve ser true ou false", 400)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	// o filtro de respondidas é feito aqui, com o set do Redis, e não no SQL:
+	// a lista de ids respondidos cresce com o histórico do usuário. Os lotes
+	// são lidos até completar a página (uma a mais que o limite, para saber
+	// se há próxima).
+	tamanhoLote := limite + 1
+	if respondidas != "" {
+		tamanhoLote = max(tamanhoLote, loteSorteio)
+	}
+	avisou := false
+
+	pagina := PaginaQuestoes{Questoes: []ItemQuestao{}}
+	for len(pagina.Questoes) <= limite {
+		lote, err := loteListagem(conn, condicoes, args, depois, tamanhoLote)
+		if err != nil {
+			logger.Println("[e] Erro ao listar questões:", err)
+			enviarErrorJson(w, "Algo deu errado", 500)
+			return
+		}
+
+		ids := make([]int, len(lote))
+		for i, q := range lote {
+			ids[i] = q.ID
+		}
+		marcadas, err := questoesRespondidas(usuario.UUID, ids)
+		if err != nil {
+			if respondidas != "" {
+				logger.Printf("[e] Não foi possível buscar as questões feitas por %v: %v\n", usuario.UUID, err)
+				enviarErrorJson(w, "Algo deu errado", 500)
+				return
+			}
+			if !avisou {
+				logger.Printf("[w] Não foi possível buscar as questões feitas por %v: %v\n", usuario.UUID, err)
+			}
+			avisou = true
+		}
+
+		for i, q := range lote {
+			q.Respondida = i < len(marcadas) && marcadas[i]
+			if respondidas == "" || (respondidas == "true") == q.Respondida {
+				pagina.Questoes = append(pagina.Questoes, q)
+			}
+		}
+		if len(lote) < tamanhoLote {
+			break
+		}
+		depois = lote[len(lote)-1].ID
+	}
+
+	if len(pagina.Questoes) > limite {
+		pagina.Questoes = pagina.Questoes[:limite]
+		pagina.ProximoCursor = codificarCursor(pagina.Questoes[limite-1].ID)
+	}
+
+	ids := make([]int, len(pagina.Questoes))
+	for i, q := range pagina.Questoes {
+		ids[i] = q.ID
+	}
+	alternativas, err := carregarAlternativas(conn, ids)
+	if err != nil {
+		logger.Println("[e] Erro ao buscar alternativas das questões:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	topicos, err := topicosDasQuestoes(conn, ids)
+	if err != nil {
+		logger.Println("[e] Erro ao buscar tópicos das questões:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	_, tags, err := carregarClassificacao(conn, ids)
+	if err != nil {
+		logger.Println("[e] Erro ao buscar tags das questões:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	for i := range pagina.Questoes {
+		id := pagina.Questoes[i].ID
+		ordem := ordemAlternativas(usuario.UUID, id, pagina.Questoes[i].Tipo, len(alternativas[id]))
+		pagina.Questoes[i].mostrarAlternativas(emOrdem(alternativas[id], ordem))
+		pagina.Questoes[i].Topicos, pagina.Questoes[i].Tags = topicos[id], tags[id]
+	}
+
+	enviarRespostaJson(w, pagina, 200)
+}
+
+// loteListagem lê as próximas questões depois do id depois.
+func loteListagem(conn *sql.DB, condicoes []string, args []any, depois, tamanho int) ([]ItemQuestao, error) {
+	rows, err := conn.Query(`
+    SELECT q.id, q.pergunta, q.tipo, q.fonte, q.atribuicao, q.dificuldade
+    FROM questoes q
+    WHERE `+strings.Join(condicoes, " AND ")+` AND q.id > ?
+    ORDER BY q.id
+    LIMIT ?`, append(slices.Clone(args), depois, tamanho)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var lote []ItemQuestao
+	for rows.Next() {
+		var q ItemQuestao
+		if err := rows.Scan(&q.ID, &q.Pergunta.Pergunta, &q.Tipo, &q.Fonte, &q.Atribuicao, &q.Dificuldade); err != nil {
+			return nil, err
+		}
+		lote = append(lote, q)
+	}
+	return lote, rows.Err()
+}
+
+// sortearQuestao escolhe uma questão que o usuário ainda não respondeu sem
+// ORDER BY RAND(), que lê a tabela inteira: sorteia um id entre o menor e o
+// maior que passam nos filtros e percorre o índice a partir dele, em lotes,
+// voltando ao começo se chegar ao fim. Quem já respondeu quase tudo faz mais
+// consultas, mas cada uma só lê ids e confere no Redis só os ids do lote.
+// Devolve sql.ErrNoRows se não sobrar nenhuma.
+func sortearQuestao(conn *sql.DB, userID string, condicoes []string, args []any) (int, error) {
+	where := strings.Join(condicoes, " AND ")
+
+	var menor, maior sql.NullInt64
+	if err := conn.QueryRow("SELECT MIN(q.id), MAX(q.id) FROM questoes q WHERE "+where, args...).Scan(&menor, &maior); err != nil {
+		return 0, err
+	}
+	if !menor.Valid {
+		return 0, sql.ErrNoRows
+	}
+	pivo := int(menor.Int64) + rand.IntN(int(maior.Int64-menor.Int64)+1)
+
+	for _, faixa := range [][2]int{{pivo, int(maior.Int64)}, {int(menor.Int64), pivo - 1}} {
+		inicio, fim := faixa[0], faixa[1]
+		for inicio <= fim {
+			rows, err := conn.Query("SELECT q.id FROM questoes q WHERE "+where+" AND q.id BETWEEN ? AND ? ORDER BY q.id LIMIT ?",
+				append(slices.Clone(args), inicio, fim, loteSorteio)...)
+			if err != nil {
+				return 0, err
+			}
+
+			var ids []int
+			for rows.Next() {
+				var id int
+				if err := rows.Scan(&id); err != nil {
+					rows.Close()
+					return 0, err
+				}
+				ids = append(ids, id)
+				inicio = id + 1
+			}
+			rows.Close()
+			if err := rows.Err(); err != nil {
+				return 0, err
+			}
+
+			respondidas, err := questoesRespondidas(userID, ids)
+			if err != nil {
+				return 0, err
+			}
+			var candidatos []int
+			for i, id := range ids {
+				if !respondidas[i] {
+					candidatos = append(candidatos, id)
+				}
+			}
+
+			if len(candidatos) > 0 {
+				return candidatos[rand.IntN(len(candidatos))], nil
+			}
+			if len(ids) < loteSorteio {
+				break
+			}
+		}
+	}
+	return 0, sql.ErrNoRows
+}
+
+// proximaQuestao atende /quest/question/next: uma questão que o usuário
+// ainda não respondeu, com os mesmos filtros da listagem.
+func proximaQuestao(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodGet {
+		w.WriteHeader(406)
+		return
+	}
+	usuario := usuarioDoContexto(r)
+
+	condicoes, args, msg := filtrosQuestoes(r)
+	if msg != "" {
+		enviarErrorJson(w, msg, 400)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	// a questão pode ser removida entre o sorteio e a leitura; tenta de novo
+	for tentativa := 0; tentativa < 3; tentativa++ {
+		id, err := sortearQuestao(conn, usuario.UUID, condicoes, args)
+		if err == sql.ErrNoRows {
+			enviarErroCodigo(w, codigoSemQuestoes, "Não há questões que você ainda não respondeu", 404)
+			return
+		} else if err != nil {
+			logger.Println("[e] Erro ao sortear questão:", err)
+			enviarErrorJson(w, "Algo deu errado", 500)
+			return
+		}
+
+		pergunta, err := carregarPergunta(conn, id, usuario.UUID)
+		if err == sql.ErrNoRows {
+			continue
+		} else if err != nil {
+			logger.Println("[e] Erro ao buscar pergunta:", err)
+			enviarErrorJson(w, "Algo deu errado", 500)
+			return
+		}
+
+		enviarRespostaJson(w, ItemQuestao{ID: id, Pergunta: pergunta}, 200)
+		return
+	}
+
+	enviarErrorJson(w, "Algo deu errado", 500)
+}
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	paseto "aidanwoods.dev/go-paseto"
+)
+
+// Chaveiro das chaves PASETO. Cada chave tem um id que vai no footer do
+// token ({"kid": "..."}), assim é possível trocar a chave de assinatura sem
+// derrubar todas as sessões: tokens novos usam a chave mais recente e as
+// antigas continuam aceitas até os tokens emitidos com elas expirarem.
+//
+// As chaves ficam num arquivo JSON (variável paseto_chaves). O comando
+// `rotacionar-chave` altera esse arquivo e o servidor em execução recarrega
+// sozinho quando percebe a mudança.
+
+const (
+	arquivoChavesPadrao = "chaves_paseto.json"
+	intervaloRecarga    = 30 * time.Second
+	// uma chave aposentada ainda verifica tokens por este tempo: a vida de um
+	// token de acesso mais o atraso máximo até o servidor recarregar o arquivo.
+	validadeAposentada = duracaoAcesso + intervaloRecarga
+)
+
+var errChaveDesconhecida = errors.New("chave do token desconhecida ou expirada")
+
+type ChavePaseto struct {
+	ID         string     `json:"id"`
+	Chave      string     `json:"chave"`
+	Criada     time.Time  `json:"criada"`
+	Aposentada *time.Time `json:"aposentada,omitempty"`
+}
+
+type rodapeToken struct {
+	Kid string `json:"kid"`
+}
+
+type Chaveiro struct {
+	mu         sync.RWMutex
+	arquivo    string
+	modificado time.Time
+	verificado time.Time
+	chaves     []ChavePaseto
+}
+
+var chaveiro *Chaveiro
+
+func arquivoChaves() string {
+	if a := os.Getenv("paseto_chaves"); a != "" {
+		return a
+	}
+	return arquivoChavesPadrao
+}
+
+// carregarChaveiro lê o arquivo de chaves. Se ele ainda não existir, é criado
+// com a paseto_key antiga do .env (para não invalidar tokens já emitidos) ou
+// com uma chave nova.
+func carregarChaveiro(arquivo string) (*Chaveiro, error) {
+	c := &Chaveiro{arquivo: arquivo}
+
+	err := c.ler()
+	if errors.Is(err, os.ErrNotExist) {
+		chave := paseto.NewV4SymmetricKey()
+		if antiga := os.Getenv("paseto_key"); antiga != "" {
+			chave, err = paseto.V4SymmetricKeyFromHex(antiga)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		id, err := gerarIdChave()
+		if err != nil {
+			return nil, err
+		}
+		c.chaves = []ChavePaseto{{ID: id, Chave: chave.ExportHex(), Criada: time.Now()}}
+		return c, c.salvar()
+	}
+
+	return c, err
+}
+
+func gerarIdChave() (string, error) {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "k" + hex.EncodeToString(b), nil
+}
+
+func (c *Chaveiro) ler() error {
+	info, err := os.Stat(c.arquivo)
+	if err != nil {
+		return err
+	}
+
+	conteudo, err := os.ReadFile(c.arquivo)
+	if err != nil {
+		return err
+	}
+
+	var chaves []ChavePaseto
+	if err := json.Unmarshal(conteudo, &chaves); err != nil {
+		return err
+	}
+	if len(chaves) == 0 {
+		return errors.New("arquivo de chaves vazio")
+	}
+
+	c.chaves = chaves
+	c.modificado = info.ModTime()
+	c.verificado = time.Now()
+	return nil
+}
+
+func (c *Chaveiro) salvar() error {
+	conteudo, err := json.MarshalIndent(c.chaves, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := c.arquivo + ".tmp"
+	if err := os.WriteFile(tmp, conteudo, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, c.arquivo)
+}
+
+// recarregarSeMudou relê o arquivo se ele foi alterado (no máximo a cada
+// intervaloRecarga).
+func (c *Chaveiro) recarregarSeMudou() {
+	c.mu.RLock()
+	recente := time.Since(c.verificado) < intervaloRecarga
+	c.mu.RUnlock()
+	if recente {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.verificado = time.Now()
+	info, err := os.Stat(c.arquivo)
+	if err != nil || !info.ModTime().After(c.modificado) {
+		return
+	}
+
+	if err := c.ler(); err != nil {
+		logger.Println("[e] Erro ao recarregar chaves PASETO:", err)
+		return
+	}
+	logger.Println("[i] Chaves PASETO recarregadas.")
+}
+
+// atual devolve a chave usada para assinar tokens novos (a mais recente).
+func (c *Chaveiro) atual() ChavePaseto {
+	c.recarregarSeMudou()
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.chaves[len(c.chaves)-1]
+}
+
+// buscar devolve a chave de id `id`, se ela ainda puder verificar tokens.
+func (c *Chaveiro) buscar(id string) (ChavePaseto, bool) {
+	c.recarregarSeMudou()
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, chave := range c.chaves {
+		if chave.ID != id {
+			continue
+		}
+		if chave.Aposentada != nil && time.Since(*chave.Aposentada) > validadeAposentada {
+			return ChavePaseto{}, false
+		}
+		return chave, true
+	}
+	return ChavePaseto{}, false
+}
+
+// rotacionar cria uma chave nova, aposenta a atual e descarta as que já não
+// verificam mais nenhum token.
+func (c *Chaveiro) rotacionar() (ChavePaseto, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id, err := gerarIdChave()
+	if err != nil {
+		return ChavePaseto{}, err
+	}
+
+	agora := time.Now()
+	var chaves []ChavePaseto
+	for _, chave := range c.chaves {
+		if chave.Aposentada == nil {
+			chave.Aposentada = &agora
+		}
+		if agora.Sub(*chave.Aposentada) <= validadeAposentada {
+			chaves = append(chaves, chave)
+		}
+	}
+
+	nova := ChavePaseto{ID: id, Chave: paseto.NewV4SymmetricKey().ExportHex(), Criada: agora}
+	c.chaves = append(chaves, nova)
+
+	return nova, c.salvar()
+}
+
+// assinarToken cifra o token com a chave atual e coloca o id dela no footer.
+func assinarToken(token paseto.Token) (string, error) {
+	chave := chaveiro.atual()
+
+	key, err := paseto.V4SymmetricKeyFromHex(chave.Chave)
+	if err != nil {
+		return "", err
+	}
+
+	rodape, err := json.Marshal(rodapeToken{Kid: chave.ID})
+	if err != nil {
+		return "", err
+	}
+	token.SetFooter(rodape)
+
+	return token.V4Encrypt(key, nil), nil
+}
+
+// lerToken escolhe a chave pelo footer do token e então o decifra e valida.
+func lerToken(tainted string) (*paseto.Token, error) {
+	parser := paseto.NewParser()
+
+	footer, err := parser.UnsafeParseFooter(paseto.V4Local, tainted)
+	if err != nil {
+		return nil, err
+	}
+
+	var rodape rodapeToken
+	if err := json.Unmarshal(footer, &rodape); err != nil {
+		return nil, err
+	}
+
+	chave, ok := chaveiro.buscar(rodape.Kid)
+	if !ok {
+		return nil, errChaveDesconhecida
+	}
+
+	key, err := paseto.V4SymmetricKeyFromHex(chave.Chave)
+	if err != nil {
+		return nil, err
+	}
+
+	return parser.ParseV4Local(key, tainted, nil)
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	paseto "aidanwoods.dev/go-paseto"
+)
+
+// loggerDeTeste descarta o log durante o teste.
+func loggerDeTeste(t *testing.T) {
+	anterior := logger
+	logger = log.New(io.Discard, "", 0)
+	t.Cleanup(func() { logger = anterior })
+}
+
+// chaveiroDeTeste troca o chaveiro por um novo, num arquivo temporário.
+func chaveiroDeTeste(t *testing.T) *Chaveiro {
+	t.Helper()
+	t.Setenv("paseto_key", "")
+	loggerDeTeste(t)
+
+	c, err := carregarChaveiro(filepath.Join(t.TempDir(), "chaves.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	anterior := chaveiro
+	chaveiro = c
+	t.Cleanup(func() { chaveiro = anterior })
+	return c
+}
+
+func tokenDeTeste(t *testing.T) string {
+	t.Helper()
+	token := paseto.NewToken()
+	token.SetExpiration(time.Now().Add(duracaoAcesso))
+	token.SetString("id", "usuario-1")
+
+	assinado, err := assinarToken(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return assinado
+}
+
+func kidDoToken(t *testing.T, token string) string {
+	t.Helper()
+	footer, err := paseto.NewParser().UnsafeParseFooter(paseto.V4Local, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rodape rodapeToken
+	if err := json.Unmarshal(footer, &rodape); err != nil {
+		t.Fatal(err)
+	}
+	return rodape.Kid
+}
+
+func TestCarregarChaveiro(t *testing.T) {
+	antiga := paseto.NewV4SymmetricKey().ExportHex()
+
+	casos := []struct {
+		nome      string
+		pasetoKey string
+	}{
+		{"sem arquivo e sem paseto_key cria uma chave", ""},
+		{"sem arquivo usa a paseto_key antiga", antiga},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			t.Setenv("paseto_key", c.pasetoKey)
+			arquivo := filepath.Join(t.TempDir(), "chaves.json")
+
+			criado, err := carregarChaveiro(arquivo)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(criado.chaves) != 1 {
+				t.Fatalf("%v chaves, esperava 1", len(criado.chaves))
+			}
+			if c.pasetoKey != "" && criado.chaves[0].Chave != c.pasetoKey {
+				t.Error("a paseto_key do .env não foi aproveitada")
+			}
+
+			// a segunda carga lê o arquivo gravado na primeira
+			lido, err := carregarChaveiro(arquivo)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if lido.chaves[0].ID != criado.chaves[0].ID || lido.chaves[0].Chave != criado.chaves[0].Chave {
+				t.Error("o arquivo não guardou a chave criada")
+			}
+		})
+	}
+}
+
+func TestRotacaoChaves(t *testing.T) {
+	casos := []struct {
+		nome string
+		// há quanto tempo a chave antiga foi aposentada; zero é agora
+		aposentadaHa time.Duration
+		valido       bool
+	}{
+		{"token da chave recém-aposentada", 0, true},
+		{"token da chave aposentada no limite", validadeAposentada - time.Minute, true},
+		{"token da chave aposentada há mais tempo", validadeAposentada + time.Minute, false},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			ch := chaveiroDeTeste(t)
+			antiga := ch.atual()
+			token := tokenDeTeste(t)
+
+			nova, err := ch.rotacionar()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ch.atual().ID != nova.ID || nova.ID == antiga.ID {
+				t.Fatalf("a chave atual é %v, esperava a nova %v", ch.atual().ID, nova.ID)
+			}
+			if kid := kidDoToken(t, tokenDeTeste(t)); kid != nova.ID {
+				t.Errorf("token novo assinado com %v, esperava %v", kid, nova.ID)
+			}
+
+			quando := time.Now().Add(-c.aposentadaHa)
+			ch.chaves[0].Aposentada = &quando
+
+			_, err = lerToken(token)
+			if c.valido && err != nil {
+				t.Errorf("token da chave antiga recusado: %v", err)
+			}
+			if !c.valido && !errors.Is(err, errChaveDesconhecida) {
+				t.Errorf("erro %v, esperava errChaveDesconhecida", err)
+			}
+		})
+	}
+}
+
+// A rotação descarta as chaves que já não verificam nenhum token.
+func TestRotacaoDescartaChavesVencidas(t *testing.T) {
+	ch := chaveiroDeTeste(t)
+	primeira := ch.atual()
+	if _, err := ch.rotacionar(); err != nil {
+		t.Fatal(err)
+	}
+
+	vencida := time.Now().Add(-validadeAposentada - time.Minute)
+	ch.chaves[0].Aposentada = &vencida
+	segunda := ch.atual()
+
+	terceira, err := ch.rotacionar()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	for _, c := range ch.chaves {
+		ids = append(ids, c.ID)
+	}
+	if len(ids) != 2 || ids[0] != segunda.ID || ids[1] != terceira.ID {
+		t.Errorf("chaves %v, esperava [%v %v] (sem %v)", ids, segunda.ID, terceira.ID, primeira.ID)
+	}
+}
+
+func TestTokenDeOutroChaveiro(t *testing.T) {
+	chaveiroDeTeste(t)
+	token := tokenDeTeste(t)
+
+	chaveiroDeTeste(t)
+	if _, err := lerToken(token); !errors.Is(err, errChaveDesconhecida) {
+		t.Errorf("erro %v, esperava errChaveDesconhecida", err)
+	}
+}
+
+// O servidor em execução percebe a rotação feita pelo comando
+// rotacionar-chave no arquivo.
+func TestRecarregarChaveiro(t *testing.T) {
+	ch := chaveiroDeTeste(t)
+	token := tokenDeTeste(t)
+
+	comando, err := carregarChaveiro(ch.arquivo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nova, err := comando.rotacionar()
+	if err != nil {
+		t.Fatal(err)
+	}
+	futuro := time.Now().Add(time.Minute)
+	if err := os.Chtimes(ch.arquivo, futuro, futuro); err != nil {
+		t.Fatal(err)
+	}
+
+	if ch.atual().ID == nova.ID {
+		t.Fatal("recarregou antes de intervaloRecarga")
+	}
+	ch.verificado = time.Now().Add(-intervaloRecarga)
+	if ch.atual().ID != nova.ID {
+		t.Fatal("não recarregou o arquivo alterado")
+	}
+	if _, err := lerToken(token); err != nil {
+		t.Errorf("token da chave anterior recusado depois da recarga: %v", err)
+	}
+}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Chaves de API para scripts e integrações, enviadas como
+// `Authorization: ApiKey bq_<id>_<segredo>`. Só o hash do segredo fica no
+// banco; a chave completa aparece uma única vez, na criação. Cada chave tem
+// escopos e só é aceita nas rotas registradas com protegidaEscopo.
+
+type Escopo string
+
+const (
+	EscopoQuestoesEscrita Escopo = "questions:write"
+	EscopoStatsLeitura    Escopo = "stats:read"
+)
+
+var escopos = []Escopo{EscopoQuestoesEscrita, EscopoStatsLeitura}
+
+const prefixoChaveAPI = "bq_"
+
+var errChaveAPIInvalida = errors.New("chave de API inválida")
+
+type ChaveAPI struct {
+	ID        string   `json:"id"`
+	Nome      string   `json:"name"`
+	Escopos   []Escopo `json:"scopes"`
+	CriadaPor *string  `json:"created_by"`
+	CriadaEm  int64    `json:"created_at"`
+	Revogada  *int64   `json:"revoked_at"`
+	UltimoUso *int64   `json:"last_used_at"`
+}
+
+func escopoValido(e Escopo) bool {
+	return slices.Contains(escopos, e)
+}
+
+func gerarIDChaveAPI() (string, error) {
+	b := make([]byte, 6)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// autenticarChaveAPI confere a chave e marca o último uso (no máximo uma
+// escrita por minuto por chave).
+func autenticarChaveAPI(chave string) (ChaveAPI, error) {
+	resto, ok := strings.CutPrefix(chave, prefixoChaveAPI)
+	if !ok {
+		return ChaveAPI{}, errChaveAPIInvalida
+	}
+	id, segredo, ok := strings.Cut(resto, "_")
+	if !ok || id == "" || segredo == "" {
+		return ChaveAPI{}, errChaveAPIInvalida
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		return ChaveAPI{}, err
+	}
+	defer conn.Close()
+
+	var hash, listaEscopos string
+	err = conn.QueryRow("SELECT hash, escopos FROM chaves_api WHERE id = ? AND revogada_em IS NULL", id).Scan(&hash, &listaEscopos)
+	if err == sql.ErrNoRows {
+		return ChaveAPI{}, errChaveAPIInvalida
+	} else if err != nil {
+		return ChaveAPI{}, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(hashSegredo(segredo))) != 1 {
+		return ChaveAPI{}, errChaveAPIInvalida
+	}
+
+	_, err = conn.Exec("UPDATE chaves_api SET ultimo_uso = NOW() WHERE id = ? AND (ultimo_uso IS NULL OR ultimo_uso < NOW() - INTERVAL 1 MINUTE)", id)
+	if err != nil {
+		logger.Printf("[w] falha ao marcar uso da chave de API %v: %v\n", id, err)
+	}
+
+	return ChaveAPI{ID: id, Escopos: separarEscopos(listaEscopos)}, nil
+}
+
+func separarEscopos(lista string) []Escopo {
+	var e []Escopo
+	for _, s := range strings.Fields(lista) {
+		e = append(e, Escopo(s))
+	}
+	return e
+}
+
+func juntarEscopos(e []Escopo) string {
+	s := make([]string, len(e))
+	for i := range e {
+		s[i] = string(e[i])
+	}
+	return strings.Join(s, " ")
+}
+
+type NovaChaveAPIData struct {
+	Nome    string   `json:"name"`
+	Escopos []Escopo `json:"scopes"`
+}
+
+type NovaChaveAPIResponse struct {
+	ChaveAPI
+	Chave string `json:"key"`
+}
+
+// chavesAPI atende /admin/apikeys: GET lista, POST cria.
+func chavesAPI(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodOptions, http.MethodGet:
+		listarChavesAPI(w, r)
+	case http.MethodPost:
+		criarChaveAPI(w, r)
+	default:
+		w.WriteHeader(406)
+	}
+}
+
+func criarChaveAPI(w http.ResponseWriter, r *http.Request) {
+	usuario := usuarioDoContexto(r)
+	var dados NovaChaveAPIData
+
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	err := d.Decode(&dados)
+
+	dados.Nome = strings.TrimSpace(dados.Nome)
+	if err != nil || d.More() || dados.Nome == "" || len(dados.Nome) > 100 || len(dados.Escopos) == 0 {
+		enviarErrorJson(w, "Estrutura do JSON incorreta.", 400)
+		return
+	}
+	for _, e := range dados.Escopos {
+		if !escopoValido(e) {
+			enviarErrorJson(w, fmt.Sprintf("Escopo inválido, use estes: %v", escopos), 400)
+			return
+		}
+	}
+	slices.Sort(dados.Escopos)
+	dados.Escopos = slices.Compact(dados.Escopos)
+
+	id, err := gerarIDChaveAPI()
+	if err != nil {
+		logger.Println("[e] Erro ao gerar id da chave de API:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	segredo, err := gerarSegredo()
+	if err != nil {
+		logger.Println("[e] Erro ao gerar chave de API:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	agora := time.Now()
+	_, err = conn.Exec("INSERT INTO chaves_api (id, nome, hash, escopos, criada_por, criada_em) VALUES (?, ?, ?, ?, ?, ?)",
+		id, dados.Nome, hashSegredo(segredo), juntarEscopos(dados.Escopos), usuario.UUID, agora)
+	if err != nil {
+		logger.Println("[e] Erro ao criar chave de API:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	registrarEvento(EventoSeguranca{UserID: usuario.UUID, IP: ipCliente(r), Tipo: eventoChaveAPICriada,
+		Detalhe: fmt.Sprintf("chave %v (%v) com escopos %v", id, dados.Nome, juntarEscopos(dados.Escopos))})
+
+	w.Header().Set("Cache-Control", "no-store")
+	enviarRespostaJson(w, NovaChaveAPIResponse{
+		ChaveAPI: ChaveAPI{ID: id, Nome: dados.Nome, Escopos: dados.Escopos, CriadaPor: &usuario.UUID, CriadaEm: agora.Unix()},
+		Chave:    prefixoChaveAPI + id + "_" + segredo,
+	}, 201)
+}
+
+func listarChavesAPI(w http.ResponseWriter, r *http.Request) {
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	rows, err := conn.Query(`
+    SELECT id, nome, escopos, criada_por, UNIX_TIMESTAMP(criada_em), UNIX_TIMESTAMP(revogada_em), UNIX_TIMESTAMP(ultimo_uso)
+    FROM chaves_api
+    ORDER BY criada_em DESC`)
+	if err != nil {
+		logger.Println("[e] Erro ao listar chaves de API:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	defer rows.Close()
+
+	chaves := []ChaveAPI{}
+	for rows.Next() {
+		var c ChaveAPI
+		var listaEscopos string
+		if err := rows.Scan(&c.ID, &c.Nome, &listaEscopos, &c.CriadaPor, &c.CriadaEm, &c.Revogada, &c.UltimoUso); err != nil {
+			logger.Println("[e] Erro ao ler chave de API:", err)
+			enviarErrorJson(w, "Algo deu errado", 500)
+			return
+		}
+		c.Escopos = separarEscopos(listaEscopos)
+		chaves = append(chaves, c)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Println("[e] Erro ao listar chaves de API:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	enviarRespostaJson(w, chaves, 200)
+}
+
+func revogarChaveAPI(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodDelete {
+		w.WriteHeader(406)
+		return
+	}
+	usuario := usuarioDoContexto(r)
+	id := r.PathValue("id")
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	res, err := conn.Exec("UPDATE chaves_api SET revogada_em = NOW() WHERE id = ? AND revogada_em IS NULL", id)
+	if err != nil {
+		logger.Printf("[e] Erro ao revogar chave de API %v: %v\n", id, err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		enviarErrorJson(w, "Chave de API não encontrada ou já revogada", 404)
+		return
+	}
+
+	registrarEvento(EventoSeguranca{UserID: usuario.UUID, IP: ipCliente(r), Tipo: eventoChaveAPIRevogada,
+		Detalhe: fmt.Sprintf("chave %v", id)})
+
+	enviarRespostaJson(w, "ok", 200)
+}
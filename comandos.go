@@ -0,0 +1,239 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+)
+
+// Comandos administrativos, executados como `./backend <comando> [args]` em
+// vez de subir o servidor.
+
+type Comando struct {
+	Descricao string
+	Executar  func(args []string) error
+}
+
+var comandos = map[string]Comando{
+	"rotacionar-chave": {
+		Descricao: "cria uma chave PASETO nova e aposenta a atual",
+		Executar:  comandoRotacionarChave,
+	},
+	"definir-papel": {
+		Descricao: "<email> <admin|teacher|student> define o papel de um usuário",
+		Executar:  comandoDefinirPapel,
+	},
+	"purgar-contas": {
+		Descricao: "apaga agora as contas com exclusão agendada vencida",
+		Executar:  comandoPurgarContas,
+	},
+	"cifrar-cpf": {
+		Descricao: "cifra os CPFs que ainda estão em texto puro no banco",
+		Executar:  comandoCifrarCPF,
+	},
+	"importar-questoes": {
+		Descricao: "[--aplicar] [--pira] <arquivo.jsonl|arquivo.csv> confere o arquivo e, com --aplicar, grava as questões (--pira: arquivo do dataset Pirá)",
+		Executar:  comandoImportarQuestoes,
+	},
+	"exportar-questoes": {
+		Descricao: "<arquivo.jsonl|arquivo.csv|-> exporta o banco de questões (- para a saída padrão, em jsonl)",
+		Executar:  comandoExportarQuestoes,
+	},
+}
+
+func executarComando(args []string) int {
+	logger = log.New(os.Stderr, log.Default().Prefix(), log.LstdFlags)
+
+	comando, ok := comandos[args[0]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Comando desconhecido: %v\n\nComandos disponíveis:\n", args[0])
+		nomes := make([]string, 0, len(comandos))
+		for nome := range comandos {
+			nomes = append(nomes, nome)
+		}
+		sort.Strings(nomes)
+		for _, nome := range nomes {
+			fmt.Fprintf(os.Stderr, "  %-20s %v\n", nome, comandos[nome].Descricao)
+		}
+		return 2
+	}
+
+	if err := comando.Executar(args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "[e] %v: %v\n", args[0], err)
+		return 1
+	}
+	return 0
+}
+
+func comandoRotacionarChave(args []string) error {
+	c, err := carregarChaveiro(arquivoChaves())
+	if err != nil {
+		return err
+	}
+
+	nova, err := c.rotacionar()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Nova chave: %v (as anteriores continuam válidas por %v)\n", nova.ID, validadeAposentada)
+	return nil
+}
+
+func comandoDefinirPapel(args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("uso: definir-papel <email> <%v|%v|%v>", PapelAdmin, PapelProfessor, PapelAluno)
+	}
+	email, papel := args[0], Papel(args[1])
+	if !papelValido(papel) {
+		return fmt.Errorf("papel inválido: %v", papel)
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err := conectarRedis(); err != nil {
+		return err
+	}
+
+	var userID string
+	err = conn.QueryRow("SELECT id FROM users WHERE email = ?", email).Scan(&userID)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("nenhum usuário com o email %v", email)
+	} else if err != nil {
+		return err
+	}
+
+	if err := definirPapel(conn, userID, papel); err != nil {
+		return err
+	}
+
+	fmt.Printf("%v agora é %v\n", email, papel)
+	return nil
+}
+
+func comandoPurgarContas(args []string) error {
+	if err := conectarRedis(); err != nil {
+		return err
+	}
+
+	n, err := purgarContasExcluidas()
+	fmt.Printf("%v conta(s) apagada(s)\n", n)
+	return err
+}
+
+func comandoCifrarCPF(args []string) error {
+	if err := iniciarCripto(); err != nil {
+		return err
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	n, err := cifrarCPFsExistentes(conn)
+	fmt.Printf("%v CPF(s) cifrado(s)\n", n)
+	return err
+}
+
+func comandoImportarQuestoes(args []string) error {
+	var aplicar, pira bool
+	for len(args) > 1 && (args[0] == "--aplicar" || args[0] == "--pira") {
+		aplicar = aplicar || args[0] == "--aplicar"
+		pira = pira || args[0] == "--pira"
+		args = args[1:]
+	}
+	if len(args) != 1 {
+		return fmt.Errorf("uso: importar-questoes [--aplicar] [--pira] <arquivo.jsonl|arquivo.csv>")
+	}
+
+	formato := formatoPorExtensao(args[0])
+	if pira {
+		formato = formatoPira
+	}
+	if formato == "" {
+		return errFormatoImportacao
+	}
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	registros, problemas, err := lerQuestoes(f, formato)
+	if err != nil {
+		return err
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	rel, err := importarQuestoes(conn, registros, problemas, !aplicar)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range rel.Problemas {
+		fmt.Printf("linha %v: %v: %v\n", p.Linha, p.Tipo, p.Mensagem)
+	}
+	fmt.Printf("%v registro(s): %v nova(s), %v atualizada(s), %v sem mudança, %v repetida(s), %v inválida(s)\n",
+		rel.Total, rel.Criadas, rel.Atualizadas, rel.Inalteradas, rel.Duplicadas, rel.Invalidas)
+
+	switch {
+	case rel.Aplicado:
+		fmt.Println("Importação gravada.")
+	case rel.Invalidas > 0:
+		return fmt.Errorf("nada foi gravado: corrija os registros inválidos")
+	default:
+		fmt.Println("Nada foi gravado (dry-run); rode de novo com --aplicar.")
+	}
+	return nil
+}
+
+func comandoExportarQuestoes(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("uso: exportar-questoes <arquivo.jsonl|arquivo.csv|->")
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if args[0] == "-" {
+		_, err := exportarQuestoes(conn, os.Stdout, formatoJSONL)
+		return err
+	}
+
+	formato := formatoPorExtensao(args[0])
+	if formato == "" {
+		return errFormatoImportacao
+	}
+
+	f, err := os.Create(args[0])
+	if err != nil {
+		return err
+	}
+	n, err := exportarQuestoes(conn, f, formato)
+	if errFechar := f.Close(); err == nil {
+		err = errFechar
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "%v questão(ões) exportada(s) para %v\n", n, args[0])
+	return nil
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Tipos de questão e correção das respostas. As alternativas ficam na tabela
+// alternativas, na ordem em que são mostradas; nas questões de resposta curta
+// a mesma tabela guarda as respostas aceitas (todas corretas), que nunca são
+// mostradas antes da resposta.
+
+type TipoQuestao string
+
+const (
+	TipoUnica           TipoQuestao = "unica"
+	TipoVerdadeiroFalso TipoQuestao = "verdadeiro_falso"
+	TipoMultipla        TipoQuestao = "multipla"
+	TipoNumerica        TipoQuestao = "numerica"
+	TipoTexto           TipoQuestao = "texto"
+)
+
+var tiposQuestao = []TipoQuestao{TipoUnica, TipoVerdadeiroFalso, TipoMultipla, TipoNumerica, TipoTexto}
+
+// folga para erros de arredondamento na comparação das respostas numéricas
+const folgaNumerica = 1e-9
+
+var semAcentos = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+type AlternativaPergunta struct {
+	Letra string `json:"letra"`
+	Texto string `json:"texto"`
+}
+
+func (t TipoQuestao) deEscolha() bool {
+	return t == TipoUnica || t == TipoVerdadeiroFalso || t == TipoMultipla
+}
+
+// carregarAlternativas busca as alternativas das questões informadas (todas,
+// se ids for nil), na ordem de exibição.
+func carregarAlternativas(db execer, ids []int) (map[int][]AlternativaQuestao, error) {
+	alternativas := map[int][]AlternativaQuestao{}
+
+	filtro, args := "", []any{}
+	if ids != nil {
+		if len(ids) == 0 {
+			return alternativas, nil
+		}
+		filtro = " WHERE questao_id IN (" + placeholders(len(ids)) + ")"
+		for _, id := range ids {
+			args = append(args, id)
+		}
+	}
+
+	rows, err := db.Query("SELECT questao_id, texto, correta FROM alternativas"+filtro+" ORDER BY questao_id, posicao", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int
+		var a AlternativaQuestao
+		if err := rows.Scan(&id, &a.Texto, &a.Correta); err != nil {
+			return nil, err
+		}
+		alternativas[id] = append(alternativas[id], a)
+	}
+	return alternativas, rows.Err()
+}
+
+// salvarAlternativas troca as alternativas (ou respostas aceitas) da questão.
+func salvarAlternativas(db execer, questaoID int, q QuestaoData) error {
+	if _, err := db.Exec("DELETE FROM alternativas WHERE questao_id = ?", questaoID); err != nil {
+		return err
+	}
+
+	alternativas := q.Alternativas
+	if q.Tipo == TipoTexto {
+		alternativas = nil
+		for _, a := range q.RespostasAceitas {
+			alternativas = append(alternativas, AlternativaQuestao{Texto: a, Correta: true})
+		}
+	}
+	if len(alternativas) == 0 {
+		return nil
+	}
+
+	var args []any
+	for i, a := range alternativas {
+		args = append(args, questaoID, i+1, a.Texto, a.Correta)
+	}
+	valores := strings.TrimSuffix(strings.Repeat("(?, ?, ?, ?), ", len(alternativas)), ", ")
+	_, err := db.Exec("INSERT INTO alternativas (questao_id, posicao, texto, correta) VALUES "+valores, args...)
+	return err
+}
+
+// usarAlternativas põe as linhas da tabela alternativas no campo certo para
+// o tipo da questão.
+func (q *QuestaoData) usarAlternativas(alternativas []AlternativaQuestao) {
+	if q.Tipo == TipoTexto {
+		q.RespostasAceitas = textosAlternativas(alternativas)
+		return
+	}
+	q.Alternativas = alternativas
+}
+
+func textosAlternativas(alternativas []AlternativaQuestao) []string {
+	textos := make([]string, len(alternativas))
+	for i, a := range alternativas {
+		textos[i] = a.Texto
+	}
+	return textos
+}
+
+// mostrarAlternativas preenche as alternativas que o usuário vê, já na ordem
+// dele (veja emOrdem), sem dizer quais estão corretas. Questões de escolha
+// única ou de verdadeiro ou falso com até cinco alternativas também vão nos
+// campos antigos alternativa_a a alternativa_e, para os clientes que ainda
+// não conhecem os tipos.
+func (p *Pergunta) mostrarAlternativas(alternativas []AlternativaQuestao) {
+	if !p.Tipo.deEscolha() {
+		return
+	}
+
+	p.Alternativas = make([]AlternativaPergunta, len(alternativas))
+	for i, a := range alternativas {
+		p.Alternativas[i] = AlternativaPergunta{Letra: letrasAlternativas[i], Texto: a.Texto}
+	}
+
+	if p.Tipo != TipoMultipla && len(alternativas) <= 5 {
+		for i, campo := range []*string{&p.AlternativaA, &p.AlternativaB, &p.AlternativaC, &p.AlternativaD, &p.AlternativaE}[:len(alternativas)] {
+			*campo = alternativas[i].Texto
+		}
+	}
+}
+
+// normalizarResposta compara respostas curtas sem diferença de maiúsculas,
+// acentos, espaços ou pontuação.
+func normalizarResposta(s string) string {
+	return normalizarPergunta(semAcentos.Replace(strings.ToLower(s)))
+}
+
+// corrigir diz se a resposta está certa. A mensagem vem preenchida quando a
+// resposta não serve para o tipo da questão (ex.: letra inexistente).
+// Questões de múltipla escolha só contam como certas com todas as corretas e
+// nenhuma errada.
+func corrigir(q QuestaoData, r RespostaQuiz) (bool, string) {
+	switch q.Tipo {
+	case TipoUnica, TipoVerdadeiroFalso:
+		i := indiceLetra(r.Alternativa)
+		if i < 0 || i >= len(q.Alternativas) {
+			return false, "Alternativa inválida"
+		}
+		return q.Alternativas[i].Correta, ""
+
+	case TipoMultipla:
+		letras := r.Alternativas
+		if len(letras) == 0 && r.Alternativa != "" {
+			letras = []string{r.Alternativa}
+		}
+		if len(letras) == 0 {
+			return false, "Escolha pelo menos uma alternativa"
+		}
+		escolhidas := make([]bool, len(q.Alternativas))
+		for _, letra := range letras {
+			i := indiceLetra(letra)
+			if i < 0 || i >= len(q.Alternativas) {
+				return false, "Alternativa inválida: " + letra
+			}
+			escolhidas[i] = true
+		}
+		for i, a := range q.Alternativas {
+			if a.Correta != escolhidas[i] {
+				return false, ""
+			}
+		}
+		return true, ""
+
+	case TipoNumerica:
+		if r.Valor == nil {
+			return false, "Informe o valor da resposta"
+		}
+		if q.RespostaNumerica == nil {
+			return false, ""
+		}
+		tolerancia := 0.0
+		if q.Tolerancia != nil {
+			tolerancia = *q.Tolerancia
+		}
+		return math.Abs(*r.Valor-*q.RespostaNumerica) <= tolerancia+folgaNumerica, ""
+
+	case TipoTexto:
+		resposta := normalizarResposta(r.Texto)
+		if resposta == "" {
+			return false, "Informe o texto da resposta"
+		}
+		return slices.ContainsFunc(q.RespostasAceitas, func(a string) bool { return normalizarResposta(a) == resposta }), ""
+	}
+	return false, "Tipo de questão desconhecido"
+}
+
+// respostaCorreta é a resposta certa em texto, como vai no campo resposta:
+// a letra, as letras separadas por vírgula, o número ou a primeira resposta
+// aceita.
+func respostaCorreta(q QuestaoData) string {
+	switch q.Tipo {
+	case TipoNumerica:
+		if q.RespostaNumerica != nil {
+			return strconv.FormatFloat(*q.RespostaNumerica, 'f', -1, 64)
+		}
+	case TipoTexto:
+		if len(q.RespostasAceitas) > 0 {
+			return q.RespostasAceitas[0]
+		}
+	default:
+		return strings.ReplaceAll(letrasCorretas(q.Alternativas), separadorLista, ",")
+	}
+	return ""
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func alternativasTeste(corretas ...bool) []AlternativaQuestao {
+	alternativas := make([]AlternativaQuestao, len(corretas))
+	for i, c := range corretas {
+		alternativas[i] = AlternativaQuestao{Texto: letrasAlternativas[i], Correta: c}
+	}
+	return alternativas
+}
+
+func numero(n float64) *float64 {
+	return &n
+}
+
+func TestCorrigir(t *testing.T) {
+	unica := QuestaoData{Tipo: TipoUnica, Alternativas: alternativasTeste(false, true, false, false, false)}
+	vf := QuestaoData{Tipo: TipoVerdadeiroFalso, Alternativas: alternativasTeste(true, false)}
+	multipla := QuestaoData{Tipo: TipoMultipla, Alternativas: alternativasTeste(true, false, true, false)}
+	numerica := QuestaoData{Tipo: TipoNumerica, RespostaNumerica: numero(9.8), Tolerancia: numero(0.05)}
+	exata := QuestaoData{Tipo: TipoNumerica, RespostaNumerica: numero(0.3)}
+	texto := QuestaoData{Tipo: TipoTexto, RespostasAceitas: []string{"Fotossíntese", "photosynthesis"}}
+
+	casos := []struct {
+		nome     string
+		questao  QuestaoData
+		resposta RespostaQuiz
+		certa    bool
+		invalida bool
+	}{
+		{"única certa", unica, RespostaQuiz{Alternativa: "B"}, true, false},
+		{"única em minúscula", unica, RespostaQuiz{Alternativa: " b "}, true, false},
+		{"única errada", unica, RespostaQuiz{Alternativa: "A"}, false, false},
+		{"única letra inexistente", unica, RespostaQuiz{Alternativa: "F"}, false, true},
+		{"única sem letra", unica, RespostaQuiz{}, false, true},
+
+		{"verdadeiro", vf, RespostaQuiz{Alternativa: "A"}, true, false},
+		{"falso", vf, RespostaQuiz{Alternativa: "B"}, false, false},
+		{"verdadeiro ou falso com C", vf, RespostaQuiz{Alternativa: "C"}, false, true},
+
+		{"múltipla todas as corretas", multipla, RespostaQuiz{Alternativas: []string{"C", "A"}}, true, false},
+		{"múltipla repetindo letra", multipla, RespostaQuiz{Alternativas: []string{"A", "C", "a"}}, true, false},
+		{"múltipla faltando uma", multipla, RespostaQuiz{Alternativas: []string{"A"}}, false, false},
+		{"múltipla com uma errada a mais", multipla, RespostaQuiz{Alternativas: []string{"A", "B", "C"}}, false, false},
+		{"múltipla pelo campo alternativa", multipla, RespostaQuiz{Alternativa: "A"}, false, false},
+		{"múltipla vazia", multipla, RespostaQuiz{}, false, true},
+		{"múltipla letra inexistente", multipla, RespostaQuiz{Alternativas: []string{"A", "E"}}, false, true},
+
+		{"numérica exata", numerica, RespostaQuiz{Valor: numero(9.8)}, true, false},
+		{"numérica na borda da tolerância", numerica, RespostaQuiz{Valor: numero(9.85)}, true, false},
+		{"numérica abaixo", numerica, RespostaQuiz{Valor: numero(9.74)}, false, false},
+		{"numérica acima", numerica, RespostaQuiz{Valor: numero(9.86)}, false, false},
+		{"numérica sem tolerância e erro de ponto flutuante", exata, RespostaQuiz{Valor: numero(0.1 + 0.2)}, true, false},
+		{"numérica sem valor", numerica, RespostaQuiz{Texto: "9.8"}, false, true},
+
+		{"texto igual", texto, RespostaQuiz{Texto: "Fotossíntese"}, true, false},
+		{"texto sem acento e em maiúsculas", texto, RespostaQuiz{Texto: "  FOTOSSINTESE. "}, true, false},
+		{"texto outra resposta aceita", texto, RespostaQuiz{Texto: "Photosynthesis!"}, true, false},
+		{"texto errado", texto, RespostaQuiz{Texto: "respiração"}, false, false},
+		{"texto vazio", texto, RespostaQuiz{Texto: " ... "}, false, true},
+
+		{"tipo desconhecido", QuestaoData{Tipo: "dissertativa"}, RespostaQuiz{Texto: "x"}, false, true},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			certa, msg := corrigir(c.questao, c.resposta)
+			if certa != c.certa || (msg != "") != c.invalida {
+				t.Errorf("corrigir = (%v, %q), esperava certa=%v inválida=%v", certa, msg, c.certa, c.invalida)
+			}
+		})
+	}
+}
+
+func TestAlternativasDasLetras(t *testing.T) {
+	casos := []struct {
+		nome    string
+		textos  []string
+		correta string
+		letras  string // letrasCorretas do resultado
+		total   int
+		erro    bool
+	}{
+		{"uma correta", []string{"a", "b", "c", "d", "e"}, "C", "C", 5, false},
+		{"várias com barra", []string{"a", "b", "c"}, "A|C", "A|C", 3, false},
+		{"várias com vírgula e espaços", []string{"a", "b", "c"}, "c, a", "A|C", 3, false},
+		{"vazias no fim ignoradas", []string{"a", "b", "", ""}, "B", "B", 2, false},
+		{"vazia no meio mantida", []string{"a", "", "c"}, "A", "A", 3, false},
+		{"letra além das alternativas", []string{"a", "b"}, "C", "", 0, true},
+		{"letra inválida", []string{"a", "b"}, "Z", "", 0, true},
+		{"sem correta", []string{"a", "b"}, "", "", 2, false},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			alternativas, msg := alternativasDasLetras(c.textos, c.correta)
+			if (msg != "") != c.erro {
+				t.Fatalf("mensagem %q, esperava erro=%v", msg, c.erro)
+			}
+			if c.erro {
+				return
+			}
+			if len(alternativas) != c.total {
+				t.Errorf("%v alternativas, esperava %v", len(alternativas), c.total)
+			}
+			if got := letrasCorretas(alternativas); got != c.letras {
+				t.Errorf("letrasCorretas = %q, esperava %q", got, c.letras)
+			}
+
+			// ida e volta pelo formato plano
+			volta, msg := alternativasDasLetras(textosAlternativas(alternativas), letrasCorretas(alternativas))
+			if msg != "" || letrasCorretas(volta) != c.letras {
+				t.Errorf("ida e volta: %q %q", letrasCorretas(volta), msg)
+			}
+		})
+	}
+}
+
+func TestRespostasAceitasSemSeparador(t *testing.T) {
+	q := Questao{QuestaoData: QuestaoData{Pergunta: "Capital?", Tipo: TipoTexto, RespostasAceitas: []string{"Brasília", "Brasilia|DF"}}}
+	if msg := validarQuestao(&q); !strings.Contains(msg, separadorLista) {
+		t.Errorf("validarQuestao = %q, esperava recusar %q", msg, separadorLista)
+	}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// O CPF fica cifrado no banco e mascarado nas respostas. O valor completo só
+// é devolvido aqui, com a senha confirmada de novo.
+
+type VerCPFData struct {
+	Senha          string `json:"senha"`
+	Reautenticacao string `json:"reauth_token"`
+}
+
+type CPFResponse struct {
+	CPF string `json:"cpf"`
+}
+
+func verCPF(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodPost {
+		w.WriteHeader(406)
+		return
+	}
+	usuario := usuarioDoContexto(r)
+	var dados VerCPFData
+
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	err := d.Decode(&dados)
+
+	if err != nil || d.More() || (dados.Senha == "" && dados.Reautenticacao == "") {
+		enviarErrorJson(w, "Estrutura do JSON incorreta.", 400)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	var senhaSalva, email, cpfCifrado string
+	err = conn.QueryRow("SELECT COALESCE(senha, ''), email, COALESCE(cpf_cifrado, '') FROM users WHERE id = ?", usuario.UUID).Scan(&senhaSalva, &email, &cpfCifrado)
+	if err == sql.ErrNoRows {
+		enviarErrorJson(w, "O usuário não existe mais", 401)
+		return
+	} else if err != nil {
+		logger.Println("[e] Erro ao buscar CPF:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	if err := confirmarIdentidade(usuario.UUID, email, ipCliente(r), senhaSalva, dados.Senha, dados.Reautenticacao); err != nil {
+		enviarErroIdentidade(w, err, "Senha incorreta")
+		return
+	}
+
+	cpf, err := decifrar(cpfCifrado)
+	if err != nil {
+		logger.Printf("[e] Erro ao decifrar CPF de %v: %v\n", usuario.UUID, err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	if cpf == "" {
+		enviarErroCodigo(w, codigoPerfilIncompleto, "CPF ainda não cadastrado", 404)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	enviarRespostaJson(w, CPFResponse{CPF: formatarCPF(cpf)}, 200)
+}
+
+// cifrarCPFsExistentes migra as linhas que ainda têm o CPF em texto puro.
+func cifrarCPFsExistentes(conn *sql.DB) (int, error) {
+	rows, err := conn.Query("SELECT id, cpf FROM users WHERE cpf_cifrado IS NULL AND cpf IS NOT NULL")
+	if err != nil {
+		return 0, err
+	}
+	type pendente struct{ id, cpf string }
+	var pendentes []pendente
+	for rows.Next() {
+		var p pendente
+		if err := rows.Scan(&p.id, &p.cpf); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		pendentes = append(pendentes, p)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for i, p := range pendentes {
+		cpf := normalizarCPF(p.cpf)
+		cifrado, err := cifrar(cpf)
+		if err != nil {
+			return i, err
+		}
+
+		_, err = conn.Exec("UPDATE users SET cpf_cifrado = ?, cpf_indice = ?, cpf = NULL WHERE id = ?", cifrado, indiceCego(cpf), p.id)
+		if err != nil {
+			return i, fmt.Errorf("usuário %v: %w", p.id, err)
+		}
+	}
+	return len(pendentes), nil
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+)
+
+// Cifra de dados sensíveis guardados no banco (CPF). A chave mestra vem da
+// variável chave_dados; dela saem uma chave AES-256-GCM para cifrar e uma
+// chave HMAC para o índice cego, que é determinístico e permite manter o
+// UNIQUE e buscar pelo valor sem guardar o texto puro.
+
+const prefixoCifra = "v1:"
+
+var (
+	chaveCifra  []byte
+	chaveIndice []byte
+)
+
+func iniciarCripto() error {
+	mestra, err := hex.DecodeString(os.Getenv("chave_dados"))
+	if err != nil || len(mestra) != 32 {
+		return errors.New("chave_dados ausente ou inválida (esperado 32 bytes em hex, gere com: openssl rand -hex 32)")
+	}
+
+	chaveCifra = derivarChave(mestra, "cifra")
+	chaveIndice = derivarChave(mestra, "indice")
+	return nil
+}
+
+func derivarChave(mestra []byte, uso string) []byte {
+	mac := hmac.New(sha256.New, mestra)
+	mac.Write([]byte("brainquest:" + uso))
+	return mac.Sum(nil)
+}
+
+func cifrar(texto string) (string, error) {
+	bloco, err := aes.NewCipher(chaveCifra)
+	if err != nil {
+		return "", err
+	}
+	gcm, err := cipher.NewGCM(bloco)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	cifrado := gcm.Seal(nonce, nonce, []byte(texto), nil)
+	return prefixoCifra + base64.StdEncoding.EncodeToString(cifrado), nil
+}
+
+// decifrar devolve "" para um campo vazio (ex.: CPF de conta criada por
+// login institucional que ainda não completou o perfil).
+func decifrar(cifrado string) (string, error) {
+	if cifrado == "" {
+		return "", nil
+	}
+
+	dados, ok := strings.CutPrefix(cifrado, prefixoCifra)
+	if !ok {
+		return "", errors.New("formato de cifra desconhecido")
+	}
+
+	bruto, err := base64.StdEncoding.DecodeString(dados)
+	if err != nil {
+		return "", err
+	}
+
+	bloco, err := aes.NewCipher(chaveCifra)
+	if err != nil {
+		return "", err
+	}
+	gcm, err := cipher.NewGCM(bloco)
+	if err != nil {
+		return "", err
+	}
+	if len(bruto) < gcm.NonceSize() {
+		return "", errors.New("cifra curta demais")
+	}
+
+	texto, err := gcm.Open(nil, bruto[:gcm.NonceSize()], bruto[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(texto), nil
+}
+
+// indiceCego é o HMAC do valor normalizado, usado no lugar do valor em
+// colunas UNIQUE e em buscas.
+func indiceCego(valor string) string {
+	mac := hmac.New(sha256.New, chaveIndice)
+	mac.Write([]byte(valor))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func normalizarCPF(cpf string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, cpf)
+}
+
+func formatarCPF(cpf string) string {
+	cpf = normalizarCPF(cpf)
+	if cpf == "" {
+		return ""
+	}
+	if len(cpf) != 11 {
+		return cpf
+	}
+	return fmt.Sprintf("%s.%s.%s-%s", cpf[0:3], cpf[3:6], cpf[6:9], cpf[9:11])
+}
+
+// mascararCPF mostra só os dígitos do meio: ***.456.789-**
+func mascararCPF(cpf string) string {
+	cpf = normalizarCPF(cpf)
+	if len(cpf) != 11 {
+		return "***.***.***-**"
+	}
+	return fmt.Sprintf("***.%s.%s-**", cpf[3:6], cpf[6:9])
+}
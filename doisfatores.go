@@ -0,0 +1,454 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Autenticação em dois fatores (opcional). Com o TOTP ativo, o /login/auth
+// não devolve a sessão: devolve um desafio de vida curta que precisa ser
+// trocado, junto com um código do app ou de recuperação, em /login/2fa.
+
+const (
+	duracaoDesafio2FA     = 5 * time.Minute
+	maxTentativas2FA      = 5
+	quantidadeRecuperacao = 10
+)
+
+func chaveDesafio2FA(hash string) string {
+	return fmt.Sprintf("desafio2fa:%s", hash)
+}
+
+func chaveUltimoPassoTOTP(userID string) string {
+	return fmt.Sprintf("user:%s:totp_ultimo", userID)
+}
+
+type DesafioResponse struct {
+	DoisFatores bool   `json:"two_factor_required"`
+	Desafio     string `json:"challenge_token"`
+	Expires     int64  `json:"expiration"`
+}
+
+// criarDesafio2FA é chamado pelo login depois da senha certa.
+func criarDesafio2FA(userID string, papel Papel) (DesafioResponse, error) {
+	segredo, err := gerarSegredo()
+	if err != nil {
+		return DesafioResponse{}, err
+	}
+
+	chave := chaveDesafio2FA(hashSegredo(segredo))
+	exp := time.Now().Add(duracaoDesafio2FA)
+
+	pipe := rdb.TxPipeline()
+	pipe.HSet(ctx, chave, "uid", userID, "papel", string(papel), "tentativas", 0)
+	pipe.ExpireAt(ctx, chave, exp)
+	indexarToken(pipe, userID, chave, duracaoDesafio2FA)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return DesafioResponse{}, err
+	}
+
+	return DesafioResponse{DoisFatores: true, Desafio: segredo, Expires: exp.Unix()}, nil
+}
+
+// verificarSegundoFator aceita um código TOTP (uma única vez por passo) ou um
+// código de recuperação ainda não usado.
+func verificarSegundoFator(conn *sql.DB, userID, codigo string) (bool, error) {
+	var segredoCifrado sql.NullString
+	err := conn.QueryRow("SELECT totp_segredo FROM users WHERE id = ? AND totp_ativo", userID).Scan(&segredoCifrado)
+	if err != nil {
+		return false, err
+	}
+
+	segredo, err := decifrar(segredoCifrado.String)
+	if err != nil {
+		return false, err
+	}
+
+	if passo, ok := passoTOTPValido(segredo, codigo, time.Now()); ok {
+		return registrarPassoTOTP(userID, passo)
+	}
+
+	return usarCodigoRecuperacao(conn, userID, codigo)
+}
+
+// scriptPassoTOTP grava o passo só se ele for mais novo que o último usado.
+// Comparar e gravar no mesmo script evita que duas requisições ao mesmo tempo
+// aceitem o mesmo código.
+var scriptPassoTOTP = redis.NewScript(`
+local ultimo = tonumber(redis.call("GET", KEYS[1]))
+if ultimo and tonumber(ARGV[1]) <= ultimo then
+	return 0
+end
+redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+return 1
+`)
+
+// registrarPassoTOTP impede que o mesmo código seja usado duas vezes.
+func registrarPassoTOTP(userID string, passo int64) (bool, error) {
+	validade := time.Duration(2*toleranciaTOTP+1) * passoTOTP
+	gravou, err := scriptPassoTOTP.Run(ctx, rdb, []string{chaveUltimoPassoTOTP(userID)}, passo, validade.Milliseconds()).Int()
+	return gravou == 1, err
+}
+
+func normalizarCodigoRecuperacao(codigo string) string {
+	return strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(codigo), "-", ""))
+}
+
+func usarCodigoRecuperacao(conn *sql.DB, userID, codigo string) (bool, error) {
+	res, err := conn.Exec("UPDATE codigos_recuperacao SET usado_em = NOW() WHERE user_id = ? AND hash = ? AND usado_em IS NULL",
+		userID, hashSegredo(normalizarCodigoRecuperacao(codigo)))
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
+
+// gerarCodigosRecuperacao troca os códigos antigos por novos e devolve os
+// novos em texto puro (só são mostrados esta vez).
+func gerarCodigosRecuperacao(conn *sql.DB, userID string) ([]string, error) {
+	tx, err := conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM codigos_recuperacao WHERE user_id = ?", userID); err != nil {
+		return nil, err
+	}
+
+	codigos := make([]string, 0, quantidadeRecuperacao)
+	for range quantidadeRecuperacao {
+		segredo, err := gerarSegredoTOTP()
+		if err != nil {
+			return nil, err
+		}
+		codigo := segredo[:4] + "-" + segredo[4:8]
+
+		if _, err := tx.Exec("INSERT INTO codigos_recuperacao (user_id, hash) VALUES (?, ?)", userID, hashSegredo(normalizarCodigoRecuperacao(codigo))); err != nil {
+			return nil, err
+		}
+		codigos = append(codigos, codigo)
+	}
+
+	return codigos, tx.Commit()
+}
+
+type InscricaoTOTPResponse struct {
+	Segredo string `json:"secret"`
+	URI     string `json:"otpauth_uri"`
+}
+
+// inscreverTOTP gera um segredo novo, que só passa a valer depois de
+// confirmarTOTP.
+func inscreverTOTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodPost {
+		w.WriteHeader(406)
+		return
+	}
+	usuario := usuarioDoContexto(r)
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	var email string
+	var ativo bool
+	if err := conn.QueryRow("SELECT email, totp_ativo FROM users WHERE id = ?", usuario.UUID).Scan(&email, &ativo); err != nil {
+		logger.Println("[e] Erro ao buscar usuário:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	if ativo {
+		enviarErrorJson(w, "A verificação em duas etapas já está ativa", http.StatusConflict)
+		return
+	}
+
+	segredo, err := gerarSegredoTOTP()
+	if err != nil {
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	cifrado, err := cifrar(segredo)
+	if err != nil {
+		logger.Println("[e] Erro ao cifrar segredo TOTP:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	if _, err := conn.Exec("UPDATE users SET totp_segredo = ? WHERE id = ?", cifrado, usuario.UUID); err != nil {
+		logger.Printf("[e] Erro ao salvar segredo TOTP de %v: %v\n", usuario.UUID, err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	enviarRespostaJson(w, InscricaoTOTPResponse{Segredo: segredo, URI: uriTOTP(segredo, email)}, 200)
+}
+
+type CodigoTOTPData struct {
+	Codigo string `json:"codigo"`
+}
+
+type CodigosRecuperacaoResponse struct {
+	Codigos []string `json:"recovery_codes"`
+}
+
+func confirmarTOTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodPost {
+		w.WriteHeader(406)
+		return
+	}
+	usuario := usuarioDoContexto(r)
+	var dados CodigoTOTPData
+
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	err := d.Decode(&dados)
+
+	if err != nil || d.More() || dados.Codigo == "" {
+		enviarErrorJson(w, "Estrutura do JSON incorreta.", 400)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	var segredoCifrado sql.NullString
+	var ativo bool
+	if err := conn.QueryRow("SELECT totp_segredo, totp_ativo FROM users WHERE id = ?", usuario.UUID).Scan(&segredoCifrado, &ativo); err != nil {
+		logger.Println("[e] Erro ao buscar usuário:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	if ativo {
+		enviarErrorJson(w, "A verificação em duas etapas já está ativa", http.StatusConflict)
+		return
+	}
+	if !segredoCifrado.Valid {
+		enviarErrorJson(w, "Comece a ativação por POST /user/2fa/enroll", http.StatusConflict)
+		return
+	}
+
+	segredo, err := decifrar(segredoCifrado.String)
+	if err != nil {
+		logger.Printf("[e] Erro ao decifrar segredo TOTP de %v: %v\n", usuario.UUID, err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	passo, ok := passoTOTPValido(segredo, dados.Codigo, time.Now())
+	if !ok {
+		enviarErroCodigo(w, codigo2FAInvalido, "Código inválido", 400)
+		return
+	}
+	if _, err := registrarPassoTOTP(usuario.UUID, passo); err != nil {
+		logger.Println("[w] falha ao registrar passo TOTP:", err)
+	}
+
+	codigos, err := gerarCodigosRecuperacao(conn, usuario.UUID)
+	if err != nil {
+		logger.Printf("[e] Erro ao gerar códigos de recuperação de %v: %v\n", usuario.UUID, err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	if _, err := conn.Exec("UPDATE users SET totp_ativo = TRUE WHERE id = ?", usuario.UUID); err != nil {
+		logger.Printf("[e] Erro ao ativar TOTP de %v: %v\n", usuario.UUID, err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	enviarRespostaJson(w, CodigosRecuperacaoResponse{Codigos: codigos}, 200)
+}
+
+type DesativarTOTPData struct {
+	Senha          string `json:"senha"`
+	Reautenticacao string `json:"reauth_token"`
+	Codigo         string `json:"codigo"`
+}
+
+func desativarTOTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodPost {
+		w.WriteHeader(406)
+		return
+	}
+	usuario := usuarioDoContexto(r)
+	var dados DesativarTOTPData
+
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	err := d.Decode(&dados)
+
+	if err != nil || d.More() || (dados.Senha == "" && dados.Reautenticacao == "") || dados.Codigo == "" {
+		enviarErrorJson(w, "Estrutura do JSON incorreta.", 400)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	var senhaSalva, email string
+	if err := conn.QueryRow("SELECT COALESCE(senha, ''), email FROM users WHERE id = ?", usuario.UUID).Scan(&senhaSalva, &email); err != nil {
+		logger.Println("[e] Erro ao buscar senha:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	if err := confirmarIdentidade(usuario.UUID, email, ipCliente(r), senhaSalva, dados.Senha, dados.Reautenticacao); err != nil {
+		enviarErroIdentidade(w, err, "Senha incorreta")
+		return
+	}
+
+	ok, err := verificarSegundoFator(conn, usuario.UUID, dados.Codigo)
+	if err == sql.ErrNoRows {
+		enviarErrorJson(w, "A verificação em duas etapas não está ativa", http.StatusConflict)
+		return
+	} else if err != nil {
+		logger.Println("[e] Erro ao verificar código 2FA:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	if !ok {
+		enviarErroCodigo(w, codigo2FAInvalido, "Código inválido", 400)
+		return
+	}
+
+	if _, err := conn.Exec("UPDATE users SET totp_segredo = NULL, totp_ativo = FALSE WHERE id = ?", usuario.UUID); err != nil {
+		logger.Printf("[e] Erro ao desativar TOTP de %v: %v\n", usuario.UUID, err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	if _, err := conn.Exec("DELETE FROM codigos_recuperacao WHERE user_id = ?", usuario.UUID); err != nil {
+		logger.Printf("[w] falha ao apagar códigos de recuperação de %v: %v\n", usuario.UUID, err)
+	}
+
+	enviarRespostaJson(w, "ok", 200)
+}
+
+type Login2FAData struct {
+	Desafio string `json:"challenge_token"`
+	Codigo  string `json:"codigo"`
+}
+
+// login2FA troca o desafio do /login/auth + código pela sessão.
+func login2FA(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodPost {
+		w.WriteHeader(406)
+		return
+	}
+	var dados Login2FAData
+
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	err := d.Decode(&dados)
+
+	if err != nil || d.More() || dados.Desafio == "" || dados.Codigo == "" {
+		enviarErrorJson(w, "Estrutura do JSON incorreta.", 400)
+		return
+	}
+
+	chave := chaveDesafio2FA(hashSegredo(dados.Desafio))
+	desafio, err := rdb.HGetAll(ctx, chave).Result()
+	if err != nil {
+		logger.Println("[e] Erro ao buscar desafio 2FA:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	if len(desafio) == 0 {
+		enviarErrorJson(w, "Desafio inválido ou expirado, faça login novamente", 401)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	// códigos errados contam para o bloqueio da conta como senhas erradas;
+	// sem isso, bastaria refazer o login para ganhar mais tentativas
+	var email string
+	err = conn.QueryRow("SELECT email FROM users WHERE id = ?", desafio["uid"]).Scan(&email)
+	if err == sql.ErrNoRows {
+		rdb.Del(ctx, chave)
+		enviarErrorJson(w, "Desafio inválido ou expirado, faça login novamente", 401)
+		return
+	} else if err != nil {
+		logger.Println("[e] Erro ao buscar usuário do desafio 2FA:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	ip := ipCliente(r)
+	espera, err := tempoBloqueioLogin(email, ip)
+	if err != nil {
+		logger.Println("[e] Erro ao verificar bloqueio de login:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	if espera > 0 {
+		enviarBloqueado(w, espera)
+		return
+	}
+
+	tentativas, err := rdb.HIncrBy(ctx, chave, "tentativas", 1).Result()
+	if err != nil {
+		logger.Println("[e] Erro ao contar tentativa 2FA:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	if tentativas > maxTentativas2FA {
+		rdb.Del(ctx, chave)
+		registrarEvento(EventoSeguranca{UserID: desafio["uid"], IP: ip, Tipo: eventoLoginFalhou,
+			Detalhe: "muitos códigos 2FA errados, desafio descartado"})
+		enviarErrorJson(w, "Muitas tentativas, faça login novamente", 401)
+		return
+	}
+
+	ok, err := verificarSegundoFator(conn, desafio["uid"], dados.Codigo)
+	if err == sql.ErrNoRows {
+		rdb.Del(ctx, chave)
+		enviarErrorJson(w, "Desafio inválido ou expirado, faça login novamente", 401)
+		return
+	} else if err != nil {
+		logger.Println("[e] Erro ao verificar código 2FA:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	if !ok {
+		registrarFalhaLogin(email, ip, desafio["uid"])
+		restantes := maxTentativas2FA - tentativas
+		enviarErroCodigo(w, codigo2FAInvalido, fmt.Sprintf("Código inválido, restam %v tentativas", restantes), 401)
+		return
+	}
+
+	rdb.Del(ctx, chave)
+	limparFalhasLogin(email)
+	registrarDiaLogin(conn, desafio["uid"])
+
+	tokens, err := iniciarSessao(desafio["uid"], Papel(desafio["papel"]), r)
+	if err != nil {
+		logger.Println("[e] Erro ao iniciar sessão:", err)
+		enviarErrorJson(w, "Erro ao criar sessão", 500)
+		return
+	}
+
+	enviarRespostaJson(w, tokens, 200)
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/smtp"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Envio de e-mails. Em produção usamos SMTP; em desenvolvimento o "outbox"
+// só escreve as mensagens num arquivo (ou no stdout) para podermos copiar os
+// links de verificação/recuperação.
+
+type Mensagem struct {
+	Para    string
+	Assunto string
+	Corpo   string
+}
+
+type Mailer interface {
+	Enviar(m Mensagem) error
+}
+
+var mailer Mailer
+
+type SMTPMailer struct {
+	Host      string
+	Porta     string
+	Usuario   string
+	Senha     string
+	Remetente string
+}
+
+func (s SMTPMailer) Enviar(m Mensagem) error {
+	var auth smtp.Auth
+	if s.Usuario != "" {
+		auth = smtp.PlainAuth("", s.Usuario, s.Senha, s.Host)
+	}
+
+	return smtp.SendMail(net.JoinHostPort(s.Host, s.Porta), auth, s.Remetente, []string{m.Para}, montarMensagem(s.Remetente, m))
+}
+
+type OutboxMailer struct {
+	mu    sync.Mutex
+	Saida io.Writer
+}
+
+func (o *OutboxMailer) Enviar(m Mensagem) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	_, err := fmt.Fprintf(o.Saida, "%s\n.\n", montarMensagem("outbox@localhost", m))
+	return err
+}
+
+func montarMensagem(remetente string, m Mensagem) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", remetente)
+	fmt.Fprintf(&b, "To: %s\r\n", m.Para)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Assunto))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(m.Corpo, "\n", "\r\n"))
+	return []byte(b.String())
+}
+
+// iniciarMailer escolhe a implementação pela variável `mailer` ("smtp" ou
+// "outbox", padrão outbox).
+func iniciarMailer() error {
+	switch os.Getenv("mailer") {
+	case "smtp":
+		porta := os.Getenv("smtp_porta")
+		if porta == "" {
+			porta = "587"
+		}
+		mailer = SMTPMailer{
+			Host:      os.Getenv("smtp_host"),
+			Porta:     porta,
+			Usuario:   os.Getenv("smtp_usuario"),
+			Senha:     os.Getenv("smtp_senha"),
+			Remetente: os.Getenv("smtp_remetente"),
+		}
+	case "", "outbox":
+		if arquivo := os.Getenv("outbox"); arquivo != "" {
+			f, err := os.OpenFile(arquivo, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+			if err != nil {
+				return err
+			}
+			mailer = &OutboxMailer{Saida: f}
+		} else {
+			mailer = &OutboxMailer{Saida: os.Stdout}
+		}
+	default:
+		return fmt.Errorf("mailer desconhecido: %v", os.Getenv("mailer"))
+	}
+	return nil
+}
+
+// enviarEmail manda a mensagem em segundo plano; falhas só vão para o log.
+func enviarEmail(m Mensagem) {
+	go func() {
+		if err := mailer.Enviar(m); err != nil {
+			logger.Printf("[e] Erro ao enviar e-mail para %v: %v\n", m.Para, err)
+		}
+	}()
+}
+
+// linkFrontend monta um link para uma página do front (variável frontend_url).
+func linkFrontend(caminho string, args ...any) string {
+	return strings.TrimSuffix(os.Getenv("frontend_url"), "/") + fmt.Sprintf(caminho, args...)
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"slices"
+)
+
+// Cada usuário vê as alternativas numa ordem própria, para que "é sempre a
+// A" não passe de um aluno para outro. A ordem sai de um HMAC do usuário e da
+// questão, então é a mesma a cada vez que a questão é carregada e não precisa
+// ser guardada; na resposta, as letras do usuário são levadas de volta para a
+// ordem do banco antes da correção. Verdadeiro ou falso fica na ordem
+// original.
+//
+// A chave do HMAC é só para isso (chave_embaralhar): trocá-la muda a ordem de
+// todo mundo, e quem estiver com uma questão aberta responde com as letras da
+// ordem antiga.
+
+var chaveEmbaralhar []byte
+
+func iniciarEmbaralhamento() error {
+	chave, err := hex.DecodeString(os.Getenv("chave_embaralhar"))
+	if err != nil || len(chave) != 32 {
+		return errors.New("chave_embaralhar ausente ou inválida (esperado 32 bytes em hex, gere com: openssl rand -hex 32)")
+	}
+	chaveEmbaralhar = chave
+	return nil
+}
+
+// ordemAlternativas devolve a ordem em que o usuário vê as n alternativas da
+// questão: ordem[i] é a posição no banco da alternativa mostrada na letra i.
+func ordemAlternativas(userID string, questaoID int, tipo TipoQuestao, n int) []int {
+	ordem := make([]int, n)
+	for i := range ordem {
+		ordem[i] = i
+	}
+	if tipo != TipoUnica && tipo != TipoMultipla {
+		return ordem
+	}
+
+	mac := hmac.New(sha256.New, chaveEmbaralhar)
+	fmt.Fprintf(mac, "%s:%d", userID, questaoID)
+	var semente [32]byte
+	copy(semente[:], mac.Sum(nil))
+
+	rand.New(rand.NewChaCha8(semente)).Shuffle(n, func(i, j int) {
+		ordem[i], ordem[j] = ordem[j], ordem[i]
+	})
+	return ordem
+}
+
+// emOrdem devolve as alternativas na ordem do usuário.
+func emOrdem(alternativas []AlternativaQuestao, ordem []int) []AlternativaQuestao {
+	saida := make([]AlternativaQuestao, len(ordem))
+	for i, original := range ordem {
+		saida[i] = alternativas[original]
+	}
+	return saida
+}
+
+// naOrdemOriginal troca as letras escolhidas pelo usuário pelas letras das
+// mesmas alternativas na ordem do banco. Letras inexistentes ficam como
+// estão, para a correção recusar.
+func (r RespostaQuiz) naOrdemOriginal(ordem []int) RespostaQuiz {
+	original := func(letra string) string {
+		i := indiceLetra(letra)
+		if i < 0 || i >= len(ordem) {
+			return letra
+		}
+		return letrasAlternativas[ordem[i]]
+	}
+
+	r.Alternativa = original(r.Alternativa)
+	r.Alternativas = slices.Clone(r.Alternativas)
+	for i := range r.Alternativas {
+		r.Alternativas[i] = original(r.Alternativas[i])
+	}
+	return r
+}
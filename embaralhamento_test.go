@@ -0,0 +1,78 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestOrdemAlternativas(t *testing.T) {
+	chaveEmbaralhar = make([]byte, 32)
+
+	ordem := ordemAlternativas("usuario-1", 42, TipoUnica, 5)
+	if !slices.Equal(ordem, ordemAlternativas("usuario-1", 42, TipoUnica, 5)) {
+		t.Fatal("a ordem mudou entre duas chamadas")
+	}
+	if ordenada := slices.Sorted(slices.Values(ordem)); !slices.Equal(ordenada, []int{0, 1, 2, 3, 4}) {
+		t.Fatalf("%v não é uma permutação de 0 a 4", ordem)
+	}
+
+	// com 5! ordens possíveis, dez usuários com a mesma ordem seria azar demais
+	iguais := 0
+	for _, uid := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"} {
+		if slices.Equal(ordem, ordemAlternativas(uid, 42, TipoUnica, 5)) {
+			iguais++
+		}
+	}
+	if iguais == 10 {
+		t.Error("a ordem não depende do usuário")
+	}
+
+	if vf := ordemAlternativas("usuario-1", 42, TipoVerdadeiroFalso, 2); !slices.Equal(vf, []int{0, 1}) {
+		t.Errorf("verdadeiro ou falso embaralhado: %v", vf)
+	}
+}
+
+func TestNaOrdemOriginal(t *testing.T) {
+	chaveEmbaralhar = make([]byte, 32)
+
+	for qid := 1; qid <= 20; qid++ {
+		ordem := ordemAlternativas("usuario-1", qid, TipoMultipla, 6)
+
+		for i, letra := range letrasAlternativas[:6] {
+			original := RespostaQuiz{Alternativa: letra}.naOrdemOriginal(ordem).Alternativa
+			// e de volta: a letra que o usuário vê para essa alternativa
+			if volta := letrasAlternativas[slices.Index(ordem, indiceLetra(original))]; volta != letra {
+				t.Fatalf("questão %v: %v -> %v -> %v", qid, letra, original, volta)
+			}
+			if original != letrasAlternativas[ordem[i]] {
+				t.Fatalf("questão %v: %v virou %v, esperava %v", qid, letra, original, letrasAlternativas[ordem[i]])
+			}
+		}
+
+		if r := (RespostaQuiz{Alternativa: "Z"}).naOrdemOriginal(ordem); r.Alternativa != "Z" {
+			t.Errorf("letra inexistente mudou para %v", r.Alternativa)
+		}
+	}
+}
+
+// A resposta certa na ordem do usuário tem que ser corrigida como certa.
+func TestCorrigirNaOrdemDoUsuario(t *testing.T) {
+	chaveEmbaralhar = make([]byte, 32)
+	q := QuestaoData{Tipo: TipoMultipla, Alternativas: alternativasTeste(false, true, false, true, false)}
+
+	for qid := 1; qid <= 20; qid++ {
+		ordem := ordemAlternativas("usuario-1", qid, q.Tipo, len(q.Alternativas))
+		vista := emOrdem(q.Alternativas, ordem)
+
+		var certas []string
+		for i, a := range vista {
+			if a.Correta {
+				certas = append(certas, letrasAlternativas[i])
+			}
+		}
+
+		if ok, msg := corrigir(q, RespostaQuiz{Alternativas: certas}.naOrdemOriginal(ordem)); !ok || msg != "" {
+			t.Errorf("questão %v: %v nas letras do usuário foi corrigida como errada (%q)", qid, certas, msg)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package main
+
+import "net/http"
+
+// Números gerais da plataforma, para o painel dos admins e para scripts com
+// uma chave de API de escopo stats:read.
+
+type Estatisticas struct {
+	Usuarios    int `json:"users"`
+	Verificados int `json:"verified_users"`
+	Questoes    int `json:"questions"`
+	Respostas   int `json:"answers"`
+	Acertos     int `json:"correct_answers"`
+	Erros       int `json:"wrong_answers"`
+}
+
+func estatisticas(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodGet {
+		w.WriteHeader(406)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	var e Estatisticas
+	err = conn.QueryRow(`
+    SELECT
+        (SELECT COUNT(*) FROM users),
+        (SELECT COUNT(*) FROM users WHERE verificado),
+        (SELECT COUNT(*) FROM questoes WHERE removida_em IS NULL),
+        COALESCE(SUM(quest_feitas), 0), COALESCE(SUM(alternativas_acertas), 0), COALESCE(SUM(alternativas_erradas), 0)
+    FROM dados`).Scan(&e.Usuarios, &e.Verificados, &e.Questoes, &e.Respostas, &e.Acertos, &e.Erros)
+	if err != nil {
+		logger.Println("[e] Erro ao calcular estatísticas:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	enviarRespostaJson(w, e, 200)
+}
@@ -0,0 +1,44 @@
+package main
+
+import "database/sql"
+
+// Eventos de segurança ficam no banco para o suporte conseguir explicar,
+// por exemplo, por que uma conta foi bloqueada.
+
+const (
+	eventoLoginFalhou         = "login_falhou"
+	eventoContaBloqueada      = "conta_bloqueada"
+	eventoIPBloqueado         = "ip_bloqueado"
+	eventoRefreshReutilizado  = "refresh_reutilizado"
+	eventoChaveAPICriada      = "chave_api_criada"
+	eventoChaveAPIRevogada    = "chave_api_revogada"
+	eventoIdentidadeVinculada = "identidade_vinculada"
+)
+
+type EventoSeguranca struct {
+	UserID  string
+	Email   string
+	IP      string
+	Tipo    string
+	Detalhe string
+}
+
+// registrarEvento grava o evento; se não der, só registra no log.
+func registrarEvento(e EventoSeguranca) {
+	conn, err := OpenConn()
+	if err != nil {
+		logger.Printf("[e] Erro ao registrar evento %v: %v\n", e.Tipo, err)
+		return
+	}
+	defer conn.Close()
+
+	_, err = conn.Exec("INSERT INTO eventos_seguranca (user_id, email, ip, tipo, detalhe) VALUES (?, ?, ?, ?, ?)",
+		nuloSeVazio(e.UserID), nuloSeVazio(e.Email), nuloSeVazio(e.IP), e.Tipo, e.Detalhe)
+	if err != nil {
+		logger.Printf("[e] Erro ao registrar evento %v: %v\n", e.Tipo, err)
+	}
+}
+
+func nuloSeVazio(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
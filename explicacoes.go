@@ -0,0 +1,182 @@
+package main
+
+import (
+	"database/sql"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Explicação e trechos de referência de cada questão. Só são mostrados
+// depois que o usuário responde: na própria resposta ou, mais tarde, na
+// revisão da questão.
+
+const maximoReferencias = 10
+
+type Referencia struct {
+	Trecho string  `json:"trecho"`
+	Fonte  *string `json:"fonte"`
+	URL    *string `json:"url"`
+}
+
+type RevisaoQuestao struct {
+	ID int `json:"id"`
+	Pergunta
+	Acertou bool `json:"acertou"`
+}
+
+// validarReferencias normaliza as referências e devolve a mensagem de erro,
+// ou "".
+func validarReferencias(referencias []Referencia) ([]Referencia, string) {
+	if len(referencias) > maximoReferencias {
+		return nil, "A questão pode ter no máximo " + strconv.Itoa(maximoReferencias) + " referências"
+	}
+
+	saida := []Referencia{}
+	for i, ref := range referencias {
+		ref.Trecho = strings.TrimSpace(ref.Trecho)
+		ref.Fonte = textoOpcional(ref.Fonte)
+		ref.URL = textoOpcional(ref.URL)
+		numero := strconv.Itoa(i + 1)
+
+		if ref.Trecho == "" {
+			return nil, "O trecho da referência " + numero + " não pode ser vazio"
+		}
+		if ref.Fonte != nil && utf8.RuneCountInString(*ref.Fonte) > 255 {
+			return nil, "A fonte da referência " + numero + " deve ter no máximo 255 caracteres"
+		}
+		if ref.URL != nil {
+			u, err := url.Parse(*ref.URL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || len(*ref.URL) > 2048 {
+				return nil, "A URL da referência " + numero + " é inválida"
+			}
+		}
+		saida = append(saida, ref)
+	}
+	return saida, ""
+}
+
+func mesmasReferencias(a, b []Referencia) bool {
+	iguais := func(x, y *string) bool {
+		return (x == nil && y == nil) || (x != nil && y != nil && *x == *y)
+	}
+	return slices.EqualFunc(a, b, func(x, y Referencia) bool {
+		return x.Trecho == y.Trecho && iguais(x.Fonte, y.Fonte) && iguais(x.URL, y.URL)
+	})
+}
+
+// carregarReferencias busca as referências das questões informadas (todas,
+// se ids for nil), na ordem cadastrada.
+func carregarReferencias(db execer, ids []int) (map[int][]Referencia, error) {
+	referencias := map[int][]Referencia{}
+
+	filtro, args := "", []any{}
+	if ids != nil {
+		if len(ids) == 0 {
+			return referencias, nil
+		}
+		filtro = " WHERE questao_id IN (" + placeholders(len(ids)) + ")"
+		for _, id := range ids {
+			args = append(args, id)
+		}
+	}
+
+	rows, err := db.Query("SELECT questao_id, trecho, fonte, url FROM referencias"+filtro+" ORDER BY questao_id, posicao", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int
+		var ref Referencia
+		if err := rows.Scan(&id, &ref.Trecho, &ref.Fonte, &ref.URL); err != nil {
+			return nil, err
+		}
+		referencias[id] = append(referencias[id], ref)
+	}
+	return referencias, rows.Err()
+}
+
+// salvarReferencias troca as referências da questão.
+func salvarReferencias(db execer, questaoID int, referencias []Referencia) error {
+	if _, err := db.Exec("DELETE FROM referencias WHERE questao_id = ?", questaoID); err != nil {
+		return err
+	}
+	if len(referencias) == 0 {
+		return nil
+	}
+
+	var args []any
+	for i, ref := range referencias {
+		args = append(args, questaoID, i+1, ref.Trecho, ref.Fonte, ref.URL)
+	}
+	valores := strings.TrimSuffix(strings.Repeat("(?, ?, ?, ?, ?), ", len(referencias)), ", ")
+	_, err := db.Exec("INSERT INTO referencias (questao_id, posicao, trecho, fonte, url) VALUES "+valores, args...)
+	return err
+}
+
+// revisarQuestao atende /quest/question/review/{id}: a questão como o
+// usuário a viu, com a resposta certa, a explicação e as referências. Só
+// para quem já respondeu.
+func revisarQuestao(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodGet {
+		w.WriteHeader(406)
+		return
+	}
+	qid, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		enviarErrorJson(w, "ID da pergunta inválido", 400)
+		return
+	}
+	usuario := usuarioDoContexto(r)
+
+	respondeu, err := usuarioJaFez(usuario.UUID, qid)
+	if err != nil {
+		logger.Printf("[e] Não foi possível verificar se %v já fez a questão %v: %v\n", usuario.UUID, qid, err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	if !respondeu {
+		enviarErroCodigo(w, codigoQuestaoNaoRespondida, "Responda a questão antes de ver a explicação", 403)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	pergunta, err := carregarPergunta(conn, qid, usuario.UUID)
+	if err == sql.ErrNoRows {
+		enviarErrorJson(w, "Questão não encontrada", 404)
+		return
+	} else if err != nil {
+		logger.Println("[e] Erro ao buscar pergunta:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	questao, err := buscarQuestaoAdmin(conn, qid)
+	if err != nil {
+		logger.Println("[e] Erro ao buscar pergunta:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	acertou, err := usuarioAcertou(usuario.UUID, qid)
+	if err != nil {
+		logger.Printf("[w] Não foi possível verificar se %v acertou a questão %v: %v\n", usuario.UUID, qid, err)
+	}
+
+	vista := questao.QuestaoData
+	vista.Alternativas = emOrdem(questao.Alternativas, ordemAlternativas(usuario.UUID, qid, questao.Tipo, len(questao.Alternativas)))
+	pergunta.Resposta = respostaCorreta(vista)
+	pergunta.Explicacao, pergunta.Referencias = questao.Explicacao, questao.Referencias
+
+	enviarRespostaJson(w, RevisaoQuestao{ID: qid, Pergunta: pergunta, Acertou: acertou}, 200)
+}
@@ -0,0 +1,725 @@
+package main
+
+import (
+	"bufio"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Importação e exportação do banco de questões em JSON Lines ou CSV, para os
+// editores de conteúdo trabalharem sem mexer em SQL. O arquivo exportado pode
+// ser editado e importado de volta: registros com id atualizam a questão,
+// registros sem id criam uma nova. Perguntas repetidas (comparadas pelo texto
+// normalizado) são ignoradas, e qualquer registro inválido impede a gravação
+// do arquivo inteiro.
+
+const (
+	formatoJSONL = "jsonl"
+	formatoCSV   = "csv"
+
+	tamanhoMaximoImportacao = 20 << 20
+)
+
+// No CSV as alternativas ficam uma por coluna (alternativa_a a alternativa_j)
+// e as letras corretas em correta; no JSON Lines também vale a lista
+// alternativas, como na API. As referências vão como lista no JSON Lines e
+// como texto JSON na célula do CSV.
+var colunasQuestao = []string{"id", "pergunta", "tipo",
+	"alternativa_a", "alternativa_b", "alternativa_c", "alternativa_d", "alternativa_e",
+	"alternativa_f", "alternativa_g", "alternativa_h", "alternativa_i", "alternativa_j",
+	"correta", "resposta_numerica", "tolerancia", "respostas_aceitas", "explicacao", "referencias", "fonte", "atribuicao", "dificuldade", "topicos", "tags"}
+
+// separa os itens de uma lista (tópicos, tags, letras corretas, respostas
+// aceitas) numa célula do CSV
+const separadorLista = "|"
+
+// Planilhas executam como fórmula a célula que começa com um destes. Na
+// exportação em CSV elas ganham um ' na frente, que a importação tira. Um
+// texto que já começava com ' antes de um destes também ganha, para voltar
+// igual.
+const inicioFormula = "=+-@\t\r"
+
+func pareceFormula(celula string) bool {
+	sem := strings.TrimLeft(celula, "'")
+	return sem != "" && strings.ContainsRune(inicioFormula, rune(sem[0]))
+}
+
+func escaparFormula(celula string) string {
+	if pareceFormula(celula) {
+		return "'" + celula
+	}
+	return celula
+}
+
+func desescaparFormula(celula string) string {
+	if strings.HasPrefix(celula, "'") && pareceFormula(celula) {
+		return celula[1:]
+	}
+	return celula
+}
+
+// nomes aceitos além dos nossos, para arquivos vindos de datasets em inglês
+var apelidosColuna = map[string]string{
+	"question":         "pergunta",
+	"a":                "alternativa_a",
+	"b":                "alternativa_b",
+	"c":                "alternativa_c",
+	"d":                "alternativa_d",
+	"e":                "alternativa_e",
+	"alternative_a":    "alternativa_a",
+	"alternative_b":    "alternativa_b",
+	"alternative_c":    "alternativa_c",
+	"alternative_d":    "alternativa_d",
+	"alternative_e":    "alternativa_e",
+	"answer":           "correta",
+	"correct":          "correta",
+	"resposta":         "correta",
+	"type":             "tipo",
+	"alternatives":     "alternativas",
+	"tolerance":        "tolerancia",
+	"numeric_answer":   "resposta_numerica",
+	"accepted_answers": "respostas_aceitas",
+	"explanation":      "explicacao",
+	"references":       "referencias",
+	"source":           "fonte",
+	"attribution":      "atribuicao",
+	"difficulty":       "dificuldade",
+	"topics":           "topicos",
+}
+
+var errFormatoImportacao = errors.New("formato desconhecido, use jsonl, csv ou pira")
+
+type RegistroImportacao struct {
+	Linha   int
+	ID      int
+	Questao QuestaoData
+
+	// alternativas no formato plano, até o fim do registro
+	Letras  []string
+	Correta string
+}
+
+type ProblemaImportacao struct {
+	Linha    int    `json:"line"`
+	Tipo     string `json:"type"`
+	Mensagem string `json:"message"`
+}
+
+type RelatorioImportacao struct {
+	DryRun      bool                 `json:"dry_run"`
+	Aplicado    bool                 `json:"applied"`
+	Total       int                  `json:"total"`
+	Criadas     int                  `json:"created"`
+	Atualizadas int                  `json:"updated"`
+	Inalteradas int                  `json:"unchanged"`
+	Duplicadas  int                  `json:"duplicates"`
+	Invalidas   int                  `json:"invalid"`
+	Problemas   []ProblemaImportacao `json:"problems"`
+}
+
+func formatoPorExtensao(nome string) string {
+	switch strings.ToLower(filepath.Ext(nome)) {
+	case ".jsonl", ".ndjson":
+		return formatoJSONL
+	case ".csv":
+		return formatoCSV
+	}
+	return ""
+}
+
+func nomeColuna(nome string) (string, bool) {
+	nome = strings.ToLower(strings.TrimSpace(nome))
+	if apelido, ok := apelidosColuna[nome]; ok {
+		nome = apelido
+	}
+	return nome, nome == "alternativas" || slices.Contains(colunasQuestao, nome)
+}
+
+// preencherRegistro copia os campos (já com o nome canônico) para o registro.
+func preencherRegistro(reg *RegistroImportacao, campo, valor string) error {
+	q := &reg.Questao
+	valor = desescaparFormula(valor)
+	switch campo {
+	case "id":
+		if valor = strings.TrimSpace(valor); valor == "" {
+			return nil
+		}
+		id, err := strconv.Atoi(valor)
+		if err != nil || id <= 0 {
+			return fmt.Errorf("id inválido: %v", valor)
+		}
+		reg.ID = id
+	case "pergunta":
+		q.Pergunta = valor
+	case "tipo":
+		q.Tipo = TipoQuestao(strings.ToLower(strings.TrimSpace(valor)))
+	case "correta":
+		reg.Correta = valor
+	case "resposta_numerica", "tolerancia":
+		if valor = strings.TrimSpace(valor); valor == "" {
+			return nil
+		}
+		n, err := strconv.ParseFloat(strings.Replace(valor, ",", ".", 1), 64)
+		if err != nil {
+			return fmt.Errorf("%v não é um número: %v", campo, valor)
+		}
+		if campo == "tolerancia" {
+			q.Tolerancia = &n
+		} else {
+			q.RespostaNumerica = &n
+		}
+	case "respostas_aceitas":
+		q.RespostasAceitas = strings.Split(valor, separadorLista)
+	case "explicacao":
+		q.Explicacao = textoOpcional(&valor)
+	case "referencias":
+		if valor = strings.TrimSpace(valor); valor == "" {
+			return nil
+		}
+		if err := json.Unmarshal([]byte(valor), &q.Referencias); err != nil {
+			return errors.New(`referencias deve ser uma lista JSON de {"trecho": ..., "fonte": ..., "url": ...}`)
+		}
+	case "alternativas":
+		return errors.New("alternativas só vale no JSON Lines; no CSV use alternativa_a a alternativa_j")
+	case "fonte":
+		q.Fonte = textoOpcional(&valor)
+	case "atribuicao":
+		q.Atribuicao = textoOpcional(&valor)
+	case "dificuldade":
+		q.Dificuldade = textoOpcional(&valor)
+	case "topicos":
+		q.Topicos = strings.Split(valor, separadorLista)
+	case "tags":
+		q.Tags = strings.Split(valor, separadorLista)
+	default:
+		if i := indiceLetra(strings.TrimPrefix(campo, "alternativa_")); strings.HasPrefix(campo, "alternativa_") && i >= 0 {
+			for len(reg.Letras) <= i {
+				reg.Letras = append(reg.Letras, "")
+			}
+			reg.Letras[i] = valor
+		}
+	}
+	return nil
+}
+
+// finalizar converte as alternativas do formato plano, depois de lidos todos
+// os campos do registro.
+func (reg *RegistroImportacao) finalizar() error {
+	if len(reg.Letras) == 0 && reg.Correta == "" {
+		return nil
+	}
+	if slices.ContainsFunc(reg.Letras, func(t string) bool { return t != "" }) && len(reg.Questao.Alternativas) > 0 {
+		return errors.New("use alternativas ou alternativa_a a alternativa_j, não os dois")
+	}
+	if len(reg.Questao.Alternativas) > 0 {
+		reg.Letras = textosAlternativas(reg.Questao.Alternativas)
+	}
+
+	alternativas, msg := alternativasDasLetras(reg.Letras, reg.Correta)
+	if msg != "" {
+		return errors.New(msg)
+	}
+	reg.Questao.Alternativas = alternativas
+	return nil
+}
+
+// lerQuestoes lê o arquivo inteiro. Erros de um registro viram problemas do
+// relatório; o erro devolvido é só para arquivos que não dá para ler.
+func lerQuestoes(r io.Reader, formato string) ([]RegistroImportacao, []ProblemaImportacao, error) {
+	switch formato {
+	case formatoJSONL:
+		return lerQuestoesJSONL(r)
+	case formatoCSV:
+		return lerQuestoesCSV(r)
+	case formatoPira:
+		return lerQuestoesPira(r)
+	}
+	return nil, nil, errFormatoImportacao
+}
+
+func lerQuestoesJSONL(r io.Reader) ([]RegistroImportacao, []ProblemaImportacao, error) {
+	var registros []RegistroImportacao
+	var problemas []ProblemaImportacao
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for linha := 1; scanner.Scan(); linha++ {
+		texto := strings.TrimSpace(scanner.Text())
+		if texto == "" {
+			continue
+		}
+
+		invalido := func(msg string) {
+			problemas = append(problemas, ProblemaImportacao{Linha: linha, Tipo: "invalida", Mensagem: msg})
+		}
+
+		var campos map[string]any
+		if err := json.Unmarshal([]byte(texto), &campos); err != nil {
+			invalido("JSON inválido: " + err.Error())
+			continue
+		}
+
+		reg := RegistroImportacao{Linha: linha}
+		ok := true
+		for nome, valor := range campos {
+			campo, conhecido := nomeColuna(nome)
+			if !conhecido {
+				invalido("campo desconhecido: " + nome)
+				ok = false
+				break
+			}
+
+			if campo == "alternativas" {
+				b, _ := json.Marshal(valor)
+				if err := json.Unmarshal(b, &reg.Questao.Alternativas); err != nil {
+					invalido(`campo alternativas deve ser uma lista de {"texto": ..., "correta": ...}`)
+					ok = false
+					break
+				}
+				continue
+			}
+			if _, lista := valor.([]any); campo == "referencias" && lista {
+				b, _ := json.Marshal(valor)
+				if err := preencherRegistro(&reg, campo, string(b)); err != nil {
+					invalido(err.Error())
+					ok = false
+					break
+				}
+				continue
+			}
+
+			var textoCampo string
+			switch v := valor.(type) {
+			case string:
+				textoCampo = v
+			case float64:
+				textoCampo = strconv.FormatFloat(v, 'f', -1, 64)
+			case []any:
+				itens := make([]string, len(v))
+				for i := range v {
+					item, ehTexto := v[i].(string)
+					if !ehTexto || strings.Contains(item, separadorLista) {
+						invalido(fmt.Sprintf("campo %v deve ser uma lista de textos sem %q", nome, separadorLista))
+						ok = false
+						break
+					}
+					itens[i] = item
+				}
+				textoCampo = strings.Join(itens, separadorLista)
+			case nil:
+			default:
+				invalido(fmt.Sprintf("campo %v deve ser texto", nome))
+				ok = false
+			}
+			if !ok {
+				break
+			}
+			if err := preencherRegistro(&reg, campo, textoCampo); err != nil {
+				invalido(err.Error())
+				ok = false
+				break
+			}
+		}
+		if ok {
+			if err := reg.finalizar(); err != nil {
+				invalido(err.Error())
+				continue
+			}
+			registros = append(registros, reg)
+		}
+	}
+	return registros, problemas, scanner.Err()
+}
+
+func lerQuestoesCSV(r io.Reader) ([]RegistroImportacao, []ProblemaImportacao, error) {
+	var registros []RegistroImportacao
+	var problemas []ProblemaImportacao
+
+	leitor := csv.NewReader(r)
+	leitor.FieldsPerRecord = -1
+
+	cabecalho, err := leitor.Read()
+	if err == io.EOF {
+		return nil, nil, nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+	cabecalho[0] = strings.TrimPrefix(cabecalho[0], "\ufeff") // BOM do Excel
+
+	colunas := make([]string, len(cabecalho))
+	for i, nome := range cabecalho {
+		campo, ok := nomeColuna(nome)
+		if !ok {
+			return nil, nil, fmt.Errorf("coluna desconhecida: %v", nome)
+		}
+		colunas[i] = campo
+	}
+
+	for {
+		valores, err := leitor.Read()
+		if err == io.EOF {
+			break
+		}
+		linha, _ := leitor.FieldPos(0)
+		if err != nil {
+			var errCSV *csv.ParseError
+			if errors.As(err, &errCSV) {
+				problemas = append(problemas, ProblemaImportacao{Linha: errCSV.StartLine, Tipo: "invalida", Mensagem: errCSV.Err.Error()})
+				continue
+			}
+			return nil, nil, err
+		}
+		if len(valores) != len(colunas) {
+			problemas = append(problemas, ProblemaImportacao{Linha: linha, Tipo: "invalida",
+				Mensagem: fmt.Sprintf("esperava %v colunas, veio %v", len(colunas), len(valores))})
+			continue
+		}
+
+		reg := RegistroImportacao{Linha: linha}
+		ok := true
+		for i, valor := range valores {
+			if err := preencherRegistro(&reg, colunas[i], valor); err != nil {
+				problemas = append(problemas, ProblemaImportacao{Linha: linha, Tipo: "invalida", Mensagem: err.Error()})
+				ok = false
+				break
+			}
+		}
+		if err := reg.finalizar(); ok && err != nil {
+			problemas = append(problemas, ProblemaImportacao{Linha: linha, Tipo: "invalida", Mensagem: err.Error()})
+			ok = false
+		}
+		if ok {
+			registros = append(registros, reg)
+		}
+	}
+	return registros, problemas, nil
+}
+
+// normalizarPergunta é a chave usada para achar perguntas repetidas: sem
+// diferença de maiúsculas, espaços ou pontuação.
+func normalizarPergunta(pergunta string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(pergunta), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}), " ")
+}
+
+func carregarQuestoes(conn *sql.DB) ([]Questao, error) {
+	rows, err := conn.Query(`
+    SELECT id, pergunta, tipo, resposta_numerica, tolerancia, explicacao, fonte, atribuicao, dificuldade
+    FROM questoes
+    WHERE removida_em IS NULL
+    ORDER BY id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var questoes []Questao
+	for rows.Next() {
+		var q Questao
+		if err := rows.Scan(&q.ID, &q.Pergunta, &q.Tipo, &q.RespostaNumerica, &q.Tolerancia, &q.Explicacao, &q.Fonte, &q.Atribuicao, &q.Dificuldade); err != nil {
+			return nil, err
+		}
+		questoes = append(questoes, q)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	alternativas, err := carregarAlternativas(conn, nil)
+	if err != nil {
+		return nil, err
+	}
+	referencias, err := carregarReferencias(conn, nil)
+	if err != nil {
+		return nil, err
+	}
+	topicos, tags, err := carregarClassificacao(conn, nil)
+	if err != nil {
+		return nil, err
+	}
+	for i := range questoes {
+		id := questoes[i].ID
+		questoes[i].usarAlternativas(alternativas[id])
+		questoes[i].Referencias = append([]Referencia{}, referencias[id]...)
+		questoes[i].Topicos = append([]string{}, topicos[id]...)
+		questoes[i].Tags = append([]string{}, tags[id]...)
+	}
+	return questoes, nil
+}
+
+// importarQuestoes confere os registros contra o banco e, se não for dry-run
+// e nenhum registro for inválido, grava tudo numa transação.
+func importarQuestoes(conn *sql.DB, registros []RegistroImportacao, problemas []ProblemaImportacao, dryRun bool) (RelatorioImportacao, error) {
+	rel := RelatorioImportacao{DryRun: dryRun, Total: len(registros) + len(problemas), Problemas: problemas, Invalidas: len(problemas)}
+	if rel.Problemas == nil {
+		rel.Problemas = []ProblemaImportacao{}
+	}
+
+	questoes, err := carregarQuestoes(conn)
+	if err != nil {
+		return rel, err
+	}
+
+	topicosValidos, err := slugsExistentes(conn)
+	if err != nil {
+		return rel, err
+	}
+
+	existentes := map[int]QuestaoData{}
+	origem := map[string]string{}
+	for _, q := range questoes {
+		existentes[q.ID] = q.QuestaoData
+		origem[normalizarPergunta(q.Pergunta)] = fmt.Sprintf("questão %v", q.ID)
+	}
+
+	var criar []Questao
+	var atualizar []Questao
+	for _, reg := range registros {
+		q := Questao{ID: reg.ID, QuestaoData: reg.Questao}
+		if msg := validarQuestao(&q); msg != "" {
+			rel.Invalidas++
+			rel.Problemas = append(rel.Problemas, ProblemaImportacao{Linha: reg.Linha, Tipo: "invalida", Mensagem: msg})
+			continue
+		}
+		if i := slices.IndexFunc(q.Topicos, func(t string) bool { return !topicosValidos[t] }); i >= 0 {
+			rel.Invalidas++
+			rel.Problemas = append(rel.Problemas, ProblemaImportacao{Linha: reg.Linha, Tipo: "invalida", Mensagem: "Tópico inexistente: " + q.Topicos[i]})
+			continue
+		}
+
+		chave := normalizarPergunta(q.Pergunta)
+		repetida, ok := origem[chave]
+
+		if q.ID == 0 {
+			if ok {
+				rel.Duplicadas++
+				rel.Problemas = append(rel.Problemas, ProblemaImportacao{Linha: reg.Linha, Tipo: "duplicada", Mensagem: "igual à " + repetida})
+				continue
+			}
+			origem[chave] = fmt.Sprintf("linha %v", reg.Linha)
+			criar = append(criar, q)
+			continue
+		}
+
+		atual, existe := existentes[q.ID]
+		if !existe {
+			rel.Invalidas++
+			rel.Problemas = append(rel.Problemas, ProblemaImportacao{Linha: reg.Linha, Tipo: "invalida", Mensagem: fmt.Sprintf("a questão %v não existe ou foi removida", q.ID)})
+			continue
+		}
+		if ok && repetida != fmt.Sprintf("questão %v", q.ID) {
+			rel.Duplicadas++
+			rel.Problemas = append(rel.Problemas, ProblemaImportacao{Linha: reg.Linha, Tipo: "duplicada", Mensagem: "igual à " + repetida})
+			continue
+		}
+		if mesmaQuestao(atual, q.QuestaoData) {
+			rel.Inalteradas++
+			continue
+		}
+
+		delete(origem, normalizarPergunta(atual.Pergunta))
+		origem[chave] = fmt.Sprintf("questão %v", q.ID)
+		existentes[q.ID] = q.QuestaoData
+		atualizar = append(atualizar, q)
+	}
+
+	rel.Criadas = len(criar)
+	rel.Atualizadas = len(atualizar)
+	if dryRun || rel.Invalidas > 0 {
+		return rel, nil
+	}
+
+	tx, err := conn.Begin()
+	if err != nil {
+		return rel, err
+	}
+	defer tx.Rollback()
+
+	for _, q := range append(criar, atualizar...) {
+		if err := gravarQuestao(tx, &q); err != nil {
+			return rel, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return rel, err
+	}
+
+	rel.Aplicado = true
+	return rel, nil
+}
+
+func exportarQuestoes(conn *sql.DB, w io.Writer, formato string) (int, error) {
+	if formato != formatoJSONL && formato != formatoCSV {
+		return 0, errFormatoImportacao
+	}
+
+	questoes, err := carregarQuestoes(conn)
+	if err != nil {
+		return 0, err
+	}
+
+	if formato == formatoJSONL {
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		for _, q := range questoes {
+			if err := enc.Encode(q); err != nil {
+				return 0, err
+			}
+		}
+		return len(questoes), nil
+	}
+
+	escritor := csv.NewWriter(w)
+	if err := escritor.Write(colunasQuestao); err != nil {
+		return 0, err
+	}
+	for _, q := range questoes {
+		letras := make([]string, len(letrasAlternativas))
+		copy(letras, textosAlternativas(q.Alternativas))
+
+		referencias := ""
+		if len(q.Referencias) > 0 {
+			b, err := json.Marshal(q.Referencias)
+			if err != nil {
+				return 0, err
+			}
+			referencias = string(b)
+		}
+
+		linha := append([]string{strconv.Itoa(q.ID), q.Pergunta, string(q.Tipo)}, letras...)
+		linha = append(linha, letrasCorretas(q.Alternativas), numeroOuVazio(q.RespostaNumerica), numeroOuVazio(q.Tolerancia),
+			strings.Join(q.RespostasAceitas, separadorLista), textoOuVazio(q.Explicacao), referencias, textoOuVazio(q.Fonte), textoOuVazio(q.Atribuicao), textoOuVazio(q.Dificuldade),
+			strings.Join(q.Topicos, separadorLista), strings.Join(q.Tags, separadorLista))
+		for i, coluna := range colunasQuestao {
+			// números negativos não são fórmula e precisam continuar números
+			if coluna != "id" && coluna != "resposta_numerica" && coluna != "tolerancia" {
+				linha[i] = escaparFormula(linha[i])
+			}
+		}
+		if err := escritor.Write(linha); err != nil {
+			return 0, err
+		}
+	}
+	escritor.Flush()
+	return len(questoes), escritor.Error()
+}
+
+// formatoDaRequisicao usa ?formato= ou, na falta dele, o Content-Type.
+func formatoDaRequisicao(r *http.Request) string {
+	if f := r.URL.Query().Get("formato"); f != "" {
+		return f
+	}
+	switch strings.TrimSpace(strings.Split(r.Header.Get("Content-Type"), ";")[0]) {
+	case "text/csv":
+		return formatoCSV
+	case "application/jsonl", "application/x-ndjson":
+		return formatoJSONL
+	}
+	return ""
+}
+
+// importarQuestoesHTTP recebe o arquivo no corpo da requisição. Com
+// ?dry_run=true só devolve o relatório.
+func importarQuestoesHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodPost {
+		w.WriteHeader(406)
+		return
+	}
+	usuario := usuarioDoContexto(r)
+
+	formato := formatoDaRequisicao(r)
+	if formato != formatoJSONL && formato != formatoCSV && formato != formatoPira {
+		enviarErrorJson(w, "Formato inválido, use jsonl, csv ou pira", 400)
+		return
+	}
+	dryRun := r.URL.Query().Get("dry_run") == "true"
+
+	registros, problemas, err := lerQuestoes(http.MaxBytesReader(w, r.Body, tamanhoMaximoImportacao), formato)
+	if err != nil {
+		enviarErrorJson(w, "Não foi possível ler o arquivo: "+err.Error(), 400)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	rel, err := importarQuestoes(conn, registros, problemas, dryRun)
+	if err != nil {
+		logger.Println("[e] Erro ao importar questões:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	if rel.Aplicado {
+		logger.Printf("[i] importação de questões por %v: %v criada(s), %v atualizada(s)\n", autorDaRequisicao(usuario), rel.Criadas, rel.Atualizadas)
+	}
+	if !dryRun && !rel.Aplicado {
+		enviarRespostaJson(w, rel, http.StatusUnprocessableEntity)
+		return
+	}
+	enviarRespostaJson(w, rel, 200)
+}
+
+func exportarQuestoesHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodGet {
+		w.WriteHeader(406)
+		return
+	}
+
+	formato := r.URL.Query().Get("formato")
+	if formato == "" {
+		formato = formatoJSONL
+	}
+	if formato != formatoJSONL && formato != formatoCSV {
+		enviarErrorJson(w, "Formato inválido, use jsonl ou csv", 400)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	if formato == formatoCSV {
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	} else {
+		w.Header().Set("Content-Type", "application/jsonl; charset=utf-8")
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="questoes.%s"`, formato))
+
+	// depois do primeiro byte não dá mais para mudar o status; só resta o log
+	if _, err := exportarQuestoes(conn, w, formato); err != nil {
+		logger.Println("[e] Erro ao exportar questões:", err)
+	}
+}
+
+func textoOuVazio(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func numeroOuVazio(n *float64) string {
+	if n == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*n, 'f', -1, 64)
+}
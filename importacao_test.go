@@ -0,0 +1,58 @@
+package main
+
+import (
+	"encoding/csv"
+	"strings"
+	"testing"
+)
+
+func TestEscaparFormula(t *testing.T) {
+	casos := []struct {
+		celula    string
+		escapada  string
+		desescapa string // o que a importação lê da célula original
+	}{
+		{"=HYPERLINK(\"http://x\")", "'=HYPERLINK(\"http://x\")", "=HYPERLINK(\"http://x\")"},
+		{"+55 34", "'+55 34", "+55 34"},
+		{"-1+1", "'-1+1", "-1+1"},
+		{"@SUM(A1)", "'@SUM(A1)", "@SUM(A1)"},
+		{"\tx", "'\tx", "\tx"},
+		{"Qual é a capital?", "Qual é a capital?", "Qual é a capital?"},
+		{"'texto com aspas", "'texto com aspas", "'texto com aspas"},
+		{"'=começa com aspas", "''=começa com aspas", "=começa com aspas"},
+		{"", "", ""},
+		{"'", "'", "'"},
+	}
+
+	for _, c := range casos {
+		if got := escaparFormula(c.celula); got != c.escapada {
+			t.Errorf("escaparFormula(%q) = %q, esperava %q", c.celula, got, c.escapada)
+		}
+		if got := desescaparFormula(escaparFormula(c.celula)); got != c.celula {
+			t.Errorf("ida e volta de %q deu %q", c.celula, got)
+		}
+		if got := desescaparFormula(c.celula); got != c.desescapa {
+			t.Errorf("desescaparFormula(%q) = %q, esperava %q", c.celula, got, c.desescapa)
+		}
+	}
+}
+
+func TestImportarCSVEscapado(t *testing.T) {
+	var b strings.Builder
+	escritor := csv.NewWriter(&b)
+	escritor.Write([]string{"pergunta", "alternativa_a", "alternativa_b", "correta", "resposta_numerica"})
+	escritor.Write([]string{escaparFormula("=1+1?"), escaparFormula("-2"), escaparFormula("@dois"), "A", "-2"})
+	escritor.Flush()
+
+	registros, problemas, err := lerQuestoesCSV(strings.NewReader(b.String()))
+	if err != nil || len(problemas) > 0 || len(registros) != 1 {
+		t.Fatalf("lerQuestoesCSV: %v %v %v", registros, problemas, err)
+	}
+	q := registros[0].Questao
+	if q.Pergunta != "=1+1?" || q.Alternativas[0].Texto != "-2" || q.Alternativas[1].Texto != "@dois" {
+		t.Errorf("o ' não foi tirado: %q %+v", q.Pergunta, q.Alternativas)
+	}
+	if q.RespostaNumerica == nil || *q.RespostaNumerica != -2 {
+		t.Errorf("resposta_numerica = %v, esperava -2", q.RespostaNumerica)
+	}
+}
@@ -0,0 +1,436 @@
+package main
+
+import (
+	"archive/zip"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// LGPD: o usuário pode baixar tudo o que guardamos sobre ele e pedir a
+// exclusão da conta. A exclusão só acontece depois de um período de carência,
+// durante o qual ela pode ser cancelada.
+
+const (
+	carenciaExclusao = 7 * 24 * time.Hour
+	intervaloPurga   = time.Hour
+)
+
+type ExportacaoUsuario struct {
+	ID         string  `json:"id"`
+	Email      string  `json:"email"`
+	CPF        string  `json:"cpf"`
+	Nome       string  `json:"nome"`
+	Telefone   *string `json:"telefone"`
+	Verificado bool    `json:"verificado"`
+	Papel      Papel   `json:"papel"`
+}
+
+type ExportacaoDados struct {
+	QuestFeitas         int   `json:"quest_feitas"`
+	AlternativasAcertas int   `json:"alternativas_acertas"`
+	AlternativasErradas int   `json:"alternativas_erradas"`
+	SequenciaAcertos    int   `json:"sequencia_acertos"`
+	XP                  int   `json:"xp"`
+	DiasLogados         int   `json:"dias_logados"`
+	UltimoLogin         int64 `json:"ultimo_login"`
+}
+
+type ExportacaoRespostas struct {
+	Feitas  []string `json:"feitas"`
+	Acertos []string `json:"acertos"`
+	Quizzes []string `json:"quizzes"`
+}
+
+type ExportacaoEvento struct {
+	Tipo     string `json:"tipo"`
+	IP       string `json:"ip"`
+	Detalhe  string `json:"detalhe"`
+	CriadoEm int64  `json:"criado_em"`
+}
+
+type ExportacaoIdentidade struct {
+	Emissor  string `json:"emissor"`
+	Sub      string `json:"sub"`
+	CriadaEm int64  `json:"criada_em"`
+}
+
+type Exportacao struct {
+	GeradoEm    int64                  `json:"gerado_em"`
+	Usuario     ExportacaoUsuario      `json:"users"`
+	Dados       ExportacaoDados        `json:"dados"`
+	Respostas   ExportacaoRespostas    `json:"respostas"`
+	Identidades []ExportacaoIdentidade `json:"identidades"`
+	Eventos     []ExportacaoEvento     `json:"eventos_seguranca"`
+}
+
+func montarExportacao(conn *sql.DB, userID string) (Exportacao, error) {
+	e := Exportacao{GeradoEm: time.Now().Unix()}
+
+	err := conn.QueryRow(`
+    SELECT
+        u.id, u.email, COALESCE(u.cpf_cifrado, ''), u.nome, u.telefone, u.verificado, u.papel,
+        d.quest_feitas, d.alternativas_acertas, d.alternativas_erradas, d.sequencia_acertos, d.xp,
+        d.dias_logados, UNIX_TIMESTAMP(d.ultimo_login)
+    FROM users u
+    JOIN dados d ON u.id = d.id
+    WHERE u.id = ?
+`, userID).Scan(
+		&e.Usuario.ID, &e.Usuario.Email, &e.Usuario.CPF, &e.Usuario.Nome, &e.Usuario.Telefone,
+		&e.Usuario.Verificado, &e.Usuario.Papel,
+		&e.Dados.QuestFeitas, &e.Dados.AlternativasAcertas, &e.Dados.AlternativasErradas,
+		&e.Dados.SequenciaAcertos, &e.Dados.XP,
+		&e.Dados.DiasLogados, &e.Dados.UltimoLogin,
+	)
+	if err != nil {
+		return e, err
+	}
+
+	// a exportação é a cópia dos dados do próprio usuário: o CPF vai completo
+	cpf, err := decifrar(e.Usuario.CPF)
+	if err != nil {
+		return e, err
+	}
+	e.Usuario.CPF = formatarCPF(cpf)
+
+	if e.Respostas.Feitas, err = listarQuestoesFeitas(userID); err != nil {
+		return e, err
+	}
+	if e.Respostas.Acertos, err = listarQuestoesAcertadas(userID); err != nil {
+		return e, err
+	}
+	if e.Respostas.Quizzes, err = listarQuizzesFeitos(userID); err != nil {
+		return e, err
+	}
+
+	identidades, err := conn.Query("SELECT emissor, sub, UNIX_TIMESTAMP(criada_em) FROM identidades WHERE user_id = ?", userID)
+	if err != nil {
+		return e, err
+	}
+	defer identidades.Close()
+
+	e.Identidades = []ExportacaoIdentidade{}
+	for identidades.Next() {
+		var i ExportacaoIdentidade
+		if err := identidades.Scan(&i.Emissor, &i.Sub, &i.CriadaEm); err != nil {
+			return e, err
+		}
+		e.Identidades = append(e.Identidades, i)
+	}
+	if err := identidades.Err(); err != nil {
+		return e, err
+	}
+
+	rows, err := conn.Query("SELECT tipo, COALESCE(ip, ''), COALESCE(detalhe, ''), UNIX_TIMESTAMP(criado_em) FROM eventos_seguranca WHERE user_id = ? ORDER BY id", userID)
+	if err != nil {
+		return e, err
+	}
+	defer rows.Close()
+
+	e.Eventos = []ExportacaoEvento{}
+	for rows.Next() {
+		var ev ExportacaoEvento
+		if err := rows.Scan(&ev.Tipo, &ev.IP, &ev.Detalhe, &ev.CriadoEm); err != nil {
+			return e, err
+		}
+		e.Eventos = append(e.Eventos, ev)
+	}
+
+	return e, rows.Err()
+}
+
+func exportarDados(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodGet {
+		w.WriteHeader(406)
+		return
+	}
+	usuario := usuarioDoContexto(r)
+
+	formato := r.URL.Query().Get("formato")
+	if formato != "" && formato != "json" && formato != "zip" {
+		enviarErrorJson(w, "Formato inválido, use json ou zip", 400)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	exportacao, err := montarExportacao(conn, usuario.UUID)
+	if err == sql.ErrNoRows {
+		enviarErrorJson(w, "O usuário não existe mais", 401)
+		return
+	} else if err != nil {
+		logger.Printf("[e] Erro ao exportar dados de %v: %v\n", usuario.UUID, err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	if formato != "zip" {
+		w.Header().Set("Content-Disposition", `attachment; filename="brainquest-dados.json"`)
+		enviarRespostaJson(w, exportacao, 200)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", `attachment; filename="brainquest-dados.zip"`)
+	w.WriteHeader(200)
+
+	z := zip.NewWriter(w)
+	arquivos := []struct {
+		nome     string
+		conteudo any
+	}{
+		{"users.json", exportacao.Usuario},
+		{"dados.json", exportacao.Dados},
+		{"respostas.json", exportacao.Respostas},
+		{"eventos_seguranca.json", exportacao.Eventos},
+		{"identidades.json", exportacao.Identidades},
+	}
+	for _, a := range arquivos {
+		f, err := z.Create(a.nome)
+		if err != nil {
+			logger.Println("[e] Erro ao montar zip:", err)
+			return
+		}
+		enc := json.NewEncoder(f)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(a.conteudo); err != nil {
+			logger.Println("[e] Erro ao montar zip:", err)
+			return
+		}
+	}
+	if err := z.Close(); err != nil {
+		logger.Println("[e] Erro ao montar zip:", err)
+	}
+}
+
+type ExclusaoData struct {
+	Senha          string `json:"senha"`
+	Reautenticacao string `json:"reauth_token"`
+}
+
+type ExclusaoResponse struct {
+	ExclusaoEm int64 `json:"exclusao_em"`
+}
+
+// agendarExclusao marca a conta para ser apagada depois da carência.
+func agendarExclusao(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodDelete {
+		w.WriteHeader(406)
+		return
+	}
+	usuario := usuarioDoContexto(r)
+	var dados ExclusaoData
+
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	err := d.Decode(&dados)
+
+	if err != nil || d.More() || (dados.Senha == "" && dados.Reautenticacao == "") {
+		enviarErrorJson(w, "Estrutura do JSON incorreta.", 400)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	var senhaSalva, email string
+	err = conn.QueryRow("SELECT COALESCE(senha, ''), email FROM users WHERE id = ?", usuario.UUID).Scan(&senhaSalva, &email)
+	if err == sql.ErrNoRows {
+		enviarErrorJson(w, "O usuário não existe mais", 401)
+		return
+	} else if err != nil {
+		logger.Println("[e] Erro ao buscar senha:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	if err := confirmarIdentidade(usuario.UUID, email, ipCliente(r), senhaSalva, dados.Senha, dados.Reautenticacao); err != nil {
+		enviarErroIdentidade(w, err, "Senha incorreta")
+		return
+	}
+
+	quando := time.Now().Add(carenciaExclusao)
+	if _, err := conn.Exec("UPDATE users SET exclusao_agendada = NOW() + INTERVAL ? SECOND WHERE id = ?", int(carenciaExclusao.Seconds()), usuario.UUID); err != nil {
+		logger.Printf("[e] Erro ao agendar exclusão de %v: %v\n", usuario.UUID, err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	enviarEmail(Mensagem{
+		Para:    email,
+		Assunto: "Brain Quest - Exclusão de conta agendada",
+		Corpo: fmt.Sprintf("Sua conta do Brain Quest e todos os seus dados serão apagados em %s.\n\n"+
+			"Até lá, você pode cancelar a exclusão entrando na sua conta.\n",
+			quando.Format("02/01/2006 15:04")),
+	})
+
+	enviarRespostaJson(w, ExclusaoResponse{ExclusaoEm: quando.Unix()}, 202)
+}
+
+func cancelarExclusao(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodPost {
+		w.WriteHeader(406)
+		return
+	}
+	usuario := usuarioDoContexto(r)
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	if _, err := conn.Exec("UPDATE users SET exclusao_agendada = NULL WHERE id = ?", usuario.UUID); err != nil {
+		logger.Printf("[e] Erro ao cancelar exclusão de %v: %v\n", usuario.UUID, err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	enviarRespostaJson(w, "ok", 200)
+}
+
+// excluirConta apaga o usuário do MariaDB e do Redis.
+func excluirConta(conn *sql.DB, userID string) error {
+	tx, err := conn.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// os contadores de login são por email, que some com a linha
+	var email string
+	if err := tx.QueryRow("SELECT email FROM users WHERE id = ? FOR UPDATE", userID).Scan(&email); err != nil {
+		return err
+	}
+
+	// as tabelas com FK para users saem primeiro
+	for _, query := range []string{
+		"DELETE FROM dados WHERE id = ?",
+		"DELETE FROM codigos_recuperacao WHERE user_id = ?",
+		"DELETE FROM eventos_seguranca WHERE user_id = ?",
+		"DELETE FROM identidades WHERE user_id = ?",
+		"DELETE FROM users WHERE id = ?",
+	} {
+		if _, err := tx.Exec(query, userID); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	if err := revogarTodasFamilias(userID); err != nil {
+		return err
+	}
+	return apagarChavesUsuario(userID, email)
+}
+
+func chaveTokensUsuario(userID string) string {
+	return fmt.Sprintf("user:%s:tokens", userID)
+}
+
+// indexarToken guarda a chave de um token do usuário (reset, verificação,
+// troca de email, desafio 2FA...) em user:{id}:tokens, que não dá para achar
+// pelo id de outro jeito, para a exclusão da conta apagar também. O índice
+// dura o mesmo que o token mais longo dentro dele.
+func indexarToken(pipe redis.Pipeliner, userID, chave string, validade time.Duration) {
+	indice := chaveTokensUsuario(userID)
+	pipe.SAdd(ctx, indice, chave)
+	pipe.ExpireNX(ctx, indice, validade)
+	pipe.ExpireGT(ctx, indice, validade)
+}
+
+// apagarChavesUsuario remove todas as chaves user:{id}:* do Redis, os tokens
+// indexados e os contadores de login do email.
+func apagarChavesUsuario(userID, email string) error {
+	tokens, err := rdb.SMembers(ctx, chaveTokensUsuario(userID)).Result()
+	if err != nil {
+		return err
+	}
+
+	email = strings.ToLower(email)
+	chaves := append(tokens, chaveFalhas("conta", email), chaveBloqueio("conta", email), chaveEspera("conta", email))
+
+	iter := rdb.Scan(ctx, 0, fmt.Sprintf("user:%s:*", userID), 100).Iterator()
+	for iter.Next(ctx) {
+		chaves = append(chaves, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+	return rdb.Del(ctx, chaves...).Err()
+}
+
+// purgarContasExcluidas apaga as contas cuja carência já acabou e devolve
+// quantas foram apagadas.
+func purgarContasExcluidas() (int, error) {
+	conn, err := OpenConn()
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	rows, err := conn.Query("SELECT id FROM users WHERE exclusao_agendada <= NOW()")
+	if err != nil {
+		return 0, err
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	var errs []error
+	apagadas := 0
+	for _, id := range ids {
+		if err := excluirConta(conn, id); err != nil {
+			errs = append(errs, fmt.Errorf("%v: %w", id, err))
+			continue
+		}
+		apagadas++
+	}
+	return apagadas, errors.Join(errs...)
+}
+
+// iniciarPurgaPeriodica roda a purga em segundo plano enquanto o servidor
+// estiver de pé.
+func iniciarPurgaPeriodica() {
+	go func() {
+		t := time.NewTicker(intervaloPurga)
+		defer t.Stop()
+		for {
+			n, err := purgarContasExcluidas()
+			if err != nil {
+				logger.Println("[e] Erro ao apagar contas excluídas:", err)
+			}
+			if n > 0 {
+				logger.Printf("[i] %v conta(s) apagada(s) após a carência.\n", n)
+			}
+			<-t.C
+		}
+	}()
+}
@@ -13,9 +13,7 @@ import (
 	"syscall"
 	"time"
 
-	paseto "aidanwoods.dev/go-paseto"
 	_ "github.com/go-sql-driver/mysql"
-	"github.com/joho/godotenv"
 	_ "github.com/joho/godotenv/autoload"
 	"github.com/redis/go-redis/v9"
 	"github.com/rs/cors"
@@ -45,7 +43,20 @@ func OpenConn() (*sql.DB, error) {
 	return db, err
 }
 
+func conectarRedis() error {
+	rdb = redis.NewClient(&redis.Options{
+		Addr:     "localhost:6379",
+		Password: "",
+		DB:       2,
+	})
+	return rdb.Ping(ctx).Err()
+}
+
 func main() {
+	if len(os.Args) > 1 {
+		os.Exit(executarComando(os.Args[1:]))
+	}
+
 	iniciarLogs()
 	testDB, err := OpenConn()
 	logger.Println("[i] Conectando ao MariaDB...")
@@ -55,40 +66,92 @@ func main() {
 	testDB.Close()
 	logger.Println("[i] MariaDB ok.")
 	logger.Println("[i] Conectando ao Redis...")
-	rdb = redis.NewClient(&redis.Options{
-		Addr:     "localhost:6379",
-		Password: "",
-		DB:       2,
-	})
+	if err := conectarRedis(); err != nil {
+		logger.Fatalln(err)
+	}
 	logger.Println("[i] Redis ok.")
 
-	logger.Println("[i] Verificando chave PASETO...")
-	if os.Getenv("paseto_key") == "" {
-		logger.Println("[w] Criando chave...")
-		key := paseto.NewV4SymmetricKey()
-		os.Setenv("paseto_key", key.ExportHex())
+	logger.Println("[i] Carregando chaves PASETO...")
+	chaveiro, err = carregarChaveiro(arquivoChaves())
+	if err != nil {
+		logger.Fatalln("[e] Erro ao carregar chaves PASETO:", err)
+	}
+	logger.Printf("[i] Chave PASETO atual: %v\n", chaveiro.atual().ID)
 
-		f, _ := os.Open(".env")
-		envmap, _ := godotenv.Parse(f)
-		envmap["paseto_key"] = key.ExportHex()
+	if err := iniciarCripto(); err != nil {
+		logger.Fatalln("[e] Erro ao configurar cifra de dados:", err)
+	}
 
-		godotenv.Write(envmap, ".env")
+	if err := iniciarEmbaralhamento(); err != nil {
+		logger.Fatalln("[e] Erro ao configurar a ordem das alternativas:", err)
 	}
 
+	if err := iniciarPoliticaSenha(); err != nil {
+		logger.Fatalln("[e] Erro ao configurar política de senha:", err)
+	}
+
+	if err := iniciarOIDC(); err != nil {
+		logger.Fatalln("[e] Erro ao configurar login institucional:", err)
+	}
+
+	if err := iniciarMailer(); err != nil {
+		logger.Fatalln("[e] Erro ao configurar envio de e-mails:", err)
+	}
+
+	iniciarPurgaPeriodica()
+
 	logger.Println("[i] Iniciando rotas...")
 	r := http.NewServeMux()
 
 	//Rotas de login
-	r.HandleFunc("/login/register", registrar)
-	r.HandleFunc("/login/auth", login)
+	r.HandleFunc("/login/register", publica(registrar))
+	r.HandleFunc("/login/auth", publica(login))
+	r.HandleFunc("/login/refresh", publica(renovarToken))
+	r.HandleFunc("/login/logout", publica(logout))
+	r.HandleFunc("/login/forgot", publica(esqueciSenha))
+	r.HandleFunc("/login/reset", publica(resetarSenha))
+	r.HandleFunc("/login/verify/{token}", publica(verificarEmail))
+	r.HandleFunc("/login/verify/resend", publica(reenviarVerificacao))
+	r.HandleFunc("/login/2fa", publica(login2FA))
+	r.HandleFunc("/login/oidc/start", publica(iniciarLoginOIDC))
+	r.HandleFunc("/login/oidc/callback", publica(callbackOIDC))
+
+	//Rotas de administração
+	r.HandleFunc("/admin/users/{id}/role", protegida(alterarPapelUsuario, PapelAdmin))
+	r.HandleFunc("/admin/apikeys", protegida(chavesAPI, PapelAdmin))
+	r.HandleFunc("/admin/apikeys/{id}", protegida(revogarChaveAPI, PapelAdmin))
+	r.HandleFunc("/admin/questions/import", protegidaEscopo(importarQuestoesHTTP, EscopoQuestoesEscrita, PapelAdmin))
+	r.HandleFunc("/admin/questions/export", protegidaEscopo(exportarQuestoesHTTP, EscopoQuestoesEscrita, PapelAdmin))
+	r.HandleFunc("/admin/topics", protegidaEscopo(criarTopico, EscopoQuestoesEscrita, PapelAdmin))
+	r.HandleFunc("/admin/stats", protegidaEscopo(estatisticas, EscopoStatsLeitura, PapelAdmin))
 
 	//Rotas do usuário
-	r.HandleFunc("/user/info", userInfo)
+	r.HandleFunc("/user/info", protegida(userInfo))
+	r.HandleFunc("/user/profile/complete", protegida(completarPerfil))
+	r.HandleFunc("/user/email", protegida(trocarEmail))
+	r.HandleFunc("/user/password", protegida(trocarSenha))
+	r.HandleFunc("/user/sessions", protegida(listarSessoes))
+	r.HandleFunc("/user/sessions/{id}", protegida(encerrarSessao))
+	r.HandleFunc("/user/2fa/enroll", protegida(inscreverTOTP))
+	r.HandleFunc("/user/2fa/confirm", protegida(confirmarTOTP))
+	r.HandleFunc("/user/2fa/disable", protegida(desativarTOTP))
+	r.HandleFunc("/user/cpf", protegida(verCPF))
+	r.HandleFunc("/user/reauth/oidc/start", protegida(iniciarReautenticacaoOIDC))
+	r.HandleFunc("/user/export", protegida(exportarDados))
+	r.HandleFunc("/user", protegida(agendarExclusao))
+	r.HandleFunc("/user/delete/cancel", protegida(cancelarExclusao))
+	r.HandleFunc("/user/email/confirm/{token}", publica(confirmarTrocaEmail))
 
 	//Rotas das perguntas
-	r.HandleFunc("/quest/question/query/{id}", buscarQuestaoId)
+	r.HandleFunc("/quest/topics", protegida(listarTopicos))
+	r.HandleFunc("/quest/questions", protegida(contaVerificada(perfilCompleto(listarQuestoes))))
+	r.HandleFunc("/quest/question/next", protegida(contaVerificada(perfilCompleto(proximaQuestao))))
+	r.HandleFunc("/quest/question/review/{id}", protegida(contaVerificada(perfilCompleto(revisarQuestao))))
+	r.HandleFunc("/quest/question", protegidaEscopo(criarQuestao, EscopoQuestoesEscrita, PapelAdmin))
+	r.HandleFunc("/quest/question/{id}", protegidaEscopo(questaoAdmin, EscopoQuestoesEscrita, PapelAdmin))
+	r.HandleFunc("/quest/question/query/{id}", protegida(contaVerificada(perfilCompleto(buscarQuestaoId))))
 	//Obtem a pergunta de id {id}
-	r.HandleFunc("/quest/question/answer/{id}", responderQuestaoId)
+	r.HandleFunc("/quest/question/answer/{id}", protegida(contaVerificada(perfilCompleto(responderQuestaoId))))
 	//Responde a pergunta de {id}
 
 	r.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
@@ -98,7 +161,7 @@ func main() {
 
 	c := cors.New(cors.Options{
 		AllowedOrigins:      []string{"*"},
-		AllowedMethods:      []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
+		AllowedMethods:      []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 		AllowedHeaders:      []string{"*"},
 		AllowCredentials:    true,
 		AllowPrivateNetwork: true,
@@ -0,0 +1,767 @@
+package main
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// Login institucional por OpenID Connect (authorization code + PKCE). O front
+// pede a URL em GET /login/oidc/start, manda o usuário para o provedor e,
+// quando ele volta para oidc_redirect_uri com code e state, repassa os dois
+// para POST /login/oidc/callback. Dali em diante é como o login por senha:
+// 2FA se estiver ativo e depois o par de tokens.
+//
+// A conta é achada pela identidade (emissor + sub). Na primeira vez, ela é
+// ligada à conta com o mesmo email, se o provedor disser que o email foi
+// verificado, ou uma conta nova é criada sem senha e sem CPF; o CPF é pedido
+// depois em POST /user/profile/complete.
+//
+// O mesmo fluxo serve para confirmar a identidade de quem já está logado
+// (GET /user/reauth/oidc/start): o provedor é obrigado a pedir o login de
+// novo e o callback devolve um reauth_token em vez da sessão.
+
+const (
+	duracaoEstadoOIDC = 10 * time.Minute
+	// intervalo mínimo entre buscas do JWKS quando aparece um kid desconhecido
+	intervaloJWKS = time.Minute
+	folgaRelogio  = time.Minute
+)
+
+var (
+	errOIDCDesativado   = errors.New("login institucional não configurado")
+	errIDTokenInvalido  = errors.New("id_token inválido")
+	errOIDCSemEmail     = errors.New("o provedor não informou o email")
+	errOIDCEmailEmUso   = errors.New("email já usado por outra conta")
+	errCodigoOIDCRecusa = errors.New("código recusado pelo provedor")
+)
+
+// provedor fica nil quando oidc_emissor não está configurado.
+var provedor *ProvedorOIDC
+
+func chaveEstadoOIDC(hash string) string {
+	return fmt.Sprintf("oidc:%s", hash)
+}
+
+type ProvedorOIDC struct {
+	Emissor      string
+	ClientID     string
+	ClientSecret string
+	RedirectURI  string
+	Escopos      string
+
+	cliente *http.Client
+
+	mu         sync.Mutex
+	descoberta *DescobertaOIDC
+	chaves     map[string]crypto.PublicKey
+	chavesEm   time.Time
+}
+
+type DescobertaOIDC struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+func iniciarOIDC() error {
+	emissor := strings.TrimSuffix(os.Getenv("oidc_emissor"), "/")
+	if emissor == "" {
+		logger.Println("[i] Login institucional (OIDC) desativado.")
+		return nil
+	}
+
+	if err := validarURLProvedor(emissor); err != nil {
+		return fmt.Errorf("oidc_emissor: %w", err)
+	}
+
+	p := &ProvedorOIDC{
+		Emissor:      emissor,
+		ClientID:     os.Getenv("oidc_client_id"),
+		ClientSecret: os.Getenv("oidc_client_secret"),
+		RedirectURI:  os.Getenv("oidc_redirect_uri"),
+		Escopos:      os.Getenv("oidc_escopos"),
+		cliente:      &http.Client{Timeout: 10 * time.Second},
+	}
+	if p.ClientID == "" || p.RedirectURI == "" {
+		return errors.New("oidc_client_id e oidc_redirect_uri são obrigatórios")
+	}
+	if p.Escopos == "" {
+		p.Escopos = "openid email profile"
+	}
+
+	provedor = p
+	logger.Printf("[i] Login institucional (OIDC) com %v\n", emissor)
+	return nil
+}
+
+// validarURLProvedor exige https, a não ser para um provedor de teste
+// rodando na própria máquina.
+func validarURLProvedor(bruta string) error {
+	u, err := url.Parse(bruta)
+	if err != nil {
+		return err
+	}
+	switch {
+	case u.Scheme == "https":
+		return nil
+	case u.Scheme == "http" && (u.Hostname() == "localhost" || u.Hostname() == "127.0.0.1"):
+		return nil
+	}
+	return fmt.Errorf("use https (http só para localhost): %v", bruta)
+}
+
+func (p *ProvedorOIDC) buscarJSON(endereco string, destino any) error {
+	resp, err := p.cliente.Get(endereco)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%v respondeu %v", endereco, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(destino)
+}
+
+// descobrir busca a configuração do provedor na primeira vez que ela é
+// necessária; se falhar, tenta de novo no próximo login.
+func (p *ProvedorOIDC) descobrir() (DescobertaOIDC, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.descoberta != nil {
+		return *p.descoberta, nil
+	}
+
+	var d DescobertaOIDC
+	if err := p.buscarJSON(p.Emissor+"/.well-known/openid-configuration", &d); err != nil {
+		return d, err
+	}
+	if d.Issuer != p.Emissor {
+		return d, fmt.Errorf("issuer %q diferente do configurado %q", d.Issuer, p.Emissor)
+	}
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" || d.JWKSURI == "" {
+		return d, errors.New("configuração do provedor incompleta")
+	}
+
+	p.descoberta = &d
+	return d, nil
+}
+
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k JWK) chavePublica() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		expoente := new(big.Int).SetBytes(e)
+		if !expoente.IsInt64() || expoente.Int64() > 1<<31-1 {
+			return nil, errors.New("expoente RSA inválido")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(expoente.Int64())}, nil
+
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, fmt.Errorf("curva não suportada: %v", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	}
+	return nil, fmt.Errorf("tipo de chave não suportado: %v", k.Kty)
+}
+
+// chave devolve a chave pública do kid, buscando o JWKS de novo quando o
+// provedor trocar de chave.
+func (p *ProvedorOIDC) chave(jwksURI, kid string) (crypto.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if k, ok := p.chaves[kid]; ok {
+		return k, nil
+	}
+	if time.Since(p.chavesEm) < intervaloJWKS {
+		return nil, fmt.Errorf("kid desconhecido: %v", kid)
+	}
+
+	var jwks struct {
+		Keys []JWK `json:"keys"`
+	}
+	p.chavesEm = time.Now()
+	if err := p.buscarJSON(jwksURI, &jwks); err != nil {
+		return nil, err
+	}
+
+	p.chaves = map[string]crypto.PublicKey{}
+	for _, k := range jwks.Keys {
+		pub, err := k.chavePublica()
+		if err != nil {
+			logger.Printf("[w] chave %v do JWKS ignorada: %v\n", k.Kid, err)
+			continue
+		}
+		p.chaves[k.Kid] = pub
+	}
+
+	if k, ok := p.chaves[kid]; ok {
+		return k, nil
+	}
+	return nil, fmt.Errorf("kid desconhecido: %v", kid)
+}
+
+// audiencia aceita "aud" como string ou lista, como permite a especificação.
+type audiencia []string
+
+func (a *audiencia) UnmarshalJSON(b []byte) error {
+	var uma string
+	if err := json.Unmarshal(b, &uma); err == nil {
+		*a = audiencia{uma}
+		return nil
+	}
+	var varias []string
+	if err := json.Unmarshal(b, &varias); err != nil {
+		return err
+	}
+	*a = varias
+	return nil
+}
+
+// verdadeiro aceita true e "true"; alguns provedores mandam email_verified
+// como texto.
+type verdadeiro bool
+
+func (v *verdadeiro) UnmarshalJSON(b []byte) error {
+	*v = string(b) == "true" || string(b) == `"true"`
+	return nil
+}
+
+type ClaimsOIDC struct {
+	Iss             string     `json:"iss"`
+	Sub             string     `json:"sub"`
+	Aud             audiencia  `json:"aud"`
+	Azp             string     `json:"azp"`
+	Exp             int64      `json:"exp"`
+	Iat             int64      `json:"iat"`
+	Nonce           string     `json:"nonce"`
+	Email           string     `json:"email"`
+	EmailVerificado verdadeiro `json:"email_verified"`
+	Nome            string     `json:"name"`
+	AuthTime        int64      `json:"auth_time"`
+}
+
+// verificarIDToken confere assinatura (RS256 ou ES256), emissor, audiência,
+// validade e nonce do id_token.
+func (p *ProvedorOIDC) verificarIDToken(d DescobertaOIDC, idToken, nonce string) (ClaimsOIDC, error) {
+	var claims ClaimsOIDC
+
+	partes := strings.Split(idToken, ".")
+	if len(partes) != 3 {
+		return claims, errIDTokenInvalido
+	}
+
+	var cabecalho struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	bruto, err := base64.RawURLEncoding.DecodeString(partes[0])
+	if err != nil || json.Unmarshal(bruto, &cabecalho) != nil {
+		return claims, errIDTokenInvalido
+	}
+	assinatura, err := base64.RawURLEncoding.DecodeString(partes[2])
+	if err != nil {
+		return claims, errIDTokenInvalido
+	}
+
+	pub, err := p.chave(d.JWKSURI, cabecalho.Kid)
+	if err != nil {
+		return claims, fmt.Errorf("%w: %v", errIDTokenInvalido, err)
+	}
+
+	h := sha256.Sum256([]byte(partes[0] + "." + partes[1]))
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		if cabecalho.Alg != "RS256" || rsa.VerifyPKCS1v15(k, crypto.SHA256, h[:], assinatura) != nil {
+			return claims, errIDTokenInvalido
+		}
+	case *ecdsa.PublicKey:
+		if cabecalho.Alg != "ES256" || len(assinatura) != 64 {
+			return claims, errIDTokenInvalido
+		}
+		r := new(big.Int).SetBytes(assinatura[:32])
+		s := new(big.Int).SetBytes(assinatura[32:])
+		if !ecdsa.Verify(k, h[:], r, s) {
+			return claims, errIDTokenInvalido
+		}
+	default:
+		return claims, errIDTokenInvalido
+	}
+
+	bruto, err = base64.RawURLEncoding.DecodeString(partes[1])
+	if err != nil || json.Unmarshal(bruto, &claims) != nil {
+		return claims, errIDTokenInvalido
+	}
+
+	agora := time.Now()
+	switch {
+	case claims.Iss != d.Issuer:
+		return claims, fmt.Errorf("%w: iss %v", errIDTokenInvalido, claims.Iss)
+	case !slices.Contains(claims.Aud, p.ClientID):
+		return claims, fmt.Errorf("%w: aud %v", errIDTokenInvalido, claims.Aud)
+	case len(claims.Aud) > 1 && claims.Azp != p.ClientID:
+		return claims, fmt.Errorf("%w: azp %v", errIDTokenInvalido, claims.Azp)
+	case time.Unix(claims.Exp, 0).Before(agora.Add(-folgaRelogio)):
+		return claims, fmt.Errorf("%w: expirado", errIDTokenInvalido)
+	case time.Unix(claims.Iat, 0).After(agora.Add(folgaRelogio)):
+		return claims, fmt.Errorf("%w: emitido no futuro", errIDTokenInvalido)
+	case subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1:
+		return claims, fmt.Errorf("%w: nonce", errIDTokenInvalido)
+	case claims.Sub == "":
+		return claims, fmt.Errorf("%w: sem sub", errIDTokenInvalido)
+	}
+
+	return claims, nil
+}
+
+// trocarCodigo troca o authorization code pelo id_token.
+func (p *ProvedorOIDC) trocarCodigo(d DescobertaOIDC, codigo, verificador string) (string, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", codigo)
+	form.Set("redirect_uri", p.RedirectURI)
+	form.Set("code_verifier", verificador)
+	if p.ClientSecret == "" {
+		form.Set("client_id", p.ClientID)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, d.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.ClientID), url.QueryEscape(p.ClientSecret))
+	}
+
+	resp, err := p.cliente.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var corpo struct {
+		IDToken   string `json:"id_token"`
+		Erro      string `json:"error"`
+		Descricao string `json:"error_description"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&corpo); err != nil {
+		return "", fmt.Errorf("resposta do token endpoint (%v): %w", resp.Status, err)
+	}
+	if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized {
+		return "", fmt.Errorf("%w: %v %v", errCodigoOIDCRecusa, corpo.Erro, corpo.Descricao)
+	}
+	if resp.StatusCode != http.StatusOK || corpo.IDToken == "" {
+		return "", fmt.Errorf("token endpoint respondeu %v %v", resp.Status, corpo.Erro)
+	}
+	return corpo.IDToken, nil
+}
+
+type estadoOIDC struct {
+	Verificador string `json:"verificador"`
+	Nonce       string `json:"nonce"`
+	// preenchidos só na reautenticação
+	UserID string `json:"uid,omitempty"`
+	Criado int64  `json:"criado,omitempty"`
+}
+
+type InicioOIDCResponse struct {
+	URL string `json:"url"`
+}
+
+func iniciarLoginOIDC(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodGet {
+		w.WriteHeader(406)
+		return
+	}
+	if provedor == nil {
+		enviarErrorJson(w, "Login institucional não configurado", 404)
+		return
+	}
+
+	iniciarFluxoOIDC(w, estadoOIDC{})
+}
+
+// iniciarReautenticacaoOIDC começa um login no provedor só para confirmar a
+// identidade do usuário logado.
+func iniciarReautenticacaoOIDC(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodGet {
+		w.WriteHeader(406)
+		return
+	}
+	if provedor == nil {
+		enviarErrorJson(w, "Login institucional não configurado", 404)
+		return
+	}
+
+	usuario := usuarioDoContexto(r)
+	iniciarFluxoOIDC(w, estadoOIDC{UserID: usuario.UUID, Criado: time.Now().Unix()})
+}
+
+func iniciarFluxoOIDC(w http.ResponseWriter, estado estadoOIDC) {
+	d, err := provedor.descobrir()
+	if err != nil {
+		logger.Println("[e] Erro ao buscar configuração do provedor OIDC:", err)
+		enviarErrorJson(w, "Provedor de identidade indisponível", 502)
+		return
+	}
+
+	state, err := gerarSegredo()
+	if err == nil {
+		estado.Nonce, err = gerarSegredo()
+	}
+	if err == nil {
+		estado.Verificador, err = gerarSegredo()
+	}
+	if err != nil {
+		logger.Println("[e] Erro ao gerar estado OIDC:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	valor, _ := json.Marshal(estado)
+	chave := chaveEstadoOIDC(hashSegredo(state))
+	pipe := rdb.TxPipeline()
+	pipe.Set(ctx, chave, valor, duracaoEstadoOIDC)
+	if estado.UserID != "" {
+		indexarToken(pipe, estado.UserID, chave, duracaoEstadoOIDC)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		logger.Println("[e] Erro ao salvar estado OIDC:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	desafio := sha256.Sum256([]byte(estado.Verificador))
+
+	destino, err := url.Parse(d.AuthorizationEndpoint)
+	if err != nil {
+		logger.Println("[e] authorization_endpoint inválido:", err)
+		enviarErrorJson(w, "Provedor de identidade indisponível", 502)
+		return
+	}
+	q := destino.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", provedor.ClientID)
+	q.Set("redirect_uri", provedor.RedirectURI)
+	q.Set("scope", provedor.Escopos)
+	q.Set("state", state)
+	q.Set("nonce", estado.Nonce)
+	q.Set("code_challenge", base64.RawURLEncoding.EncodeToString(desafio[:]))
+	q.Set("code_challenge_method", "S256")
+	if estado.UserID != "" {
+		// uma sessão já aberta no provedor não serve como confirmação
+		q.Set("prompt", "login")
+		q.Set("max_age", "0")
+	}
+	destino.RawQuery = q.Encode()
+
+	w.Header().Set("Cache-Control", "no-store")
+	enviarRespostaJson(w, InicioOIDCResponse{URL: destino.String()}, 200)
+}
+
+type CallbackOIDCData struct {
+	Code  string `json:"code"`
+	State string `json:"state"`
+}
+
+func callbackOIDC(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodPost {
+		w.WriteHeader(406)
+		return
+	}
+	if provedor == nil {
+		enviarErrorJson(w, "Login institucional não configurado", 404)
+		return
+	}
+	var dados CallbackOIDCData
+
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	err := d.Decode(&dados)
+
+	if err != nil || d.More() || dados.Code == "" || dados.State == "" {
+		enviarErrorJson(w, "Estrutura do JSON incorreta.", 400)
+		return
+	}
+
+	// o state só vale uma vez
+	valor, err := rdb.GetDel(ctx, chaveEstadoOIDC(hashSegredo(dados.State))).Bytes()
+	if err == redis.Nil {
+		enviarErrorJson(w, "Login expirado ou já concluído, tente de novo", 401)
+		return
+	} else if err != nil {
+		logger.Println("[e] Erro ao buscar estado OIDC:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	var estado estadoOIDC
+	if err := json.Unmarshal(valor, &estado); err != nil {
+		logger.Println("[e] Estado OIDC corrompido:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	descoberta, err := provedor.descobrir()
+	if err != nil {
+		logger.Println("[e] Erro ao buscar configuração do provedor OIDC:", err)
+		enviarErrorJson(w, "Provedor de identidade indisponível", 502)
+		return
+	}
+
+	idToken, err := provedor.trocarCodigo(descoberta, dados.Code, estado.Verificador)
+	if errors.Is(err, errCodigoOIDCRecusa) {
+		logger.Println("[w] Código OIDC recusado:", err)
+		enviarErrorJson(w, "Não foi possível confirmar o login com o provedor", 401)
+		return
+	} else if err != nil {
+		logger.Println("[e] Erro ao trocar código OIDC:", err)
+		enviarErrorJson(w, "Provedor de identidade indisponível", 502)
+		return
+	}
+
+	claims, err := provedor.verificarIDToken(descoberta, idToken, estado.Nonce)
+	if err != nil {
+		logger.Println("[w] id_token recusado:", err)
+		enviarErrorJson(w, "Não foi possível confirmar o login com o provedor", 401)
+		return
+	}
+
+	if estado.UserID != "" {
+		concluirReautenticacaoOIDC(w, claims, estado)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	conta, err := contaDaIdentidade(conn, claims, ipCliente(r))
+	if err == errOIDCSemEmail {
+		enviarErrorJson(w, "O provedor não informou seu email", 400)
+		return
+	} else if err == errOIDCEmailEmUso {
+		enviarErrorJson(w, "Já existe uma conta com este email; entre com a senha", 409)
+		return
+	} else if err != nil {
+		logger.Println("[e] Erro ao vincular identidade OIDC:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	if conta.TOTPAtivo {
+		desafio, err := criarDesafio2FA(conta.ID, conta.Papel)
+		if err != nil {
+			logger.Println("[e] Erro ao criar desafio 2FA:", err)
+			enviarErrorJson(w, "Erro ao criar sessão", 500)
+			return
+		}
+		enviarRespostaJson(w, desafio, 200)
+		return
+	}
+
+	registrarDiaLogin(conn, conta.ID)
+
+	tokens, err := iniciarSessao(conta.ID, conta.Papel, r)
+	if err != nil {
+		logger.Println("[e] Erro ao iniciar sessão:", err)
+		enviarErrorJson(w, "Erro ao criar sessão", 500)
+		return
+	}
+	tokens.PerfilIncompleto = !conta.PerfilCompleto
+
+	enviarRespostaJson(w, tokens, 200)
+}
+
+type ContaOIDC struct {
+	ID             string
+	Papel          Papel
+	TOTPAtivo      bool
+	PerfilCompleto bool
+}
+
+// contaDaIdentidade acha a conta ligada à identidade, ligando ou criando uma
+// no primeiro acesso.
+func contaDaIdentidade(conn *sql.DB, claims ClaimsOIDC, ip string) (ContaOIDC, error) {
+	var c ContaOIDC
+	err := conn.QueryRow(`
+    SELECT u.id, u.papel, u.totp_ativo, u.cpf_cifrado IS NOT NULL
+    FROM identidades i
+    JOIN users u ON u.id = i.user_id
+    WHERE i.emissor = ? AND i.sub = ?`, claims.Iss, claims.Sub).Scan(&c.ID, &c.Papel, &c.TOTPAtivo, &c.PerfilCompleto)
+	if err != sql.ErrNoRows {
+		return c, err
+	}
+
+	if claims.Email == "" {
+		return c, errOIDCSemEmail
+	}
+
+	var verificado bool
+	err = conn.QueryRow("SELECT id, papel, totp_ativo, cpf_cifrado IS NOT NULL, verificado FROM users WHERE email = ?", claims.Email).
+		Scan(&c.ID, &c.Papel, &c.TOTPAtivo, &c.PerfilCompleto, &verificado)
+	if err == nil {
+		// sem a confirmação do provedor, qualquer um poderia tomar a conta
+		// cadastrando o email da vítima no provedor
+		if !claims.EmailVerificado {
+			return c, errOIDCEmailEmUso
+		}
+		tx, err := conn.Begin()
+		if err != nil {
+			return c, err
+		}
+		defer tx.Rollback()
+
+		if _, err := tx.Exec("INSERT INTO identidades (emissor, sub, user_id) VALUES (?, ?, ?)", claims.Iss, claims.Sub, c.ID); err != nil {
+			return c, err
+		}
+		if !verificado {
+			// ninguém provou ser dono do email antes: a senha e o 2FA podem ser
+			// de quem cadastrou o email da vítima esperando por esse login
+			if _, err := tx.Exec("UPDATE users SET verificado = TRUE, senha = NULL, totp_segredo = NULL, totp_ativo = FALSE WHERE id = ?", c.ID); err != nil {
+				return c, err
+			}
+			if _, err := tx.Exec("DELETE FROM codigos_recuperacao WHERE user_id = ?", c.ID); err != nil {
+				return c, err
+			}
+			c.TOTPAtivo = false
+		}
+		if err := tx.Commit(); err != nil {
+			return c, err
+		}
+		if !verificado {
+			invalidarCacheUsuario(c.ID)
+			if err := encerrarSessoes(c.ID); err != nil {
+				return c, err
+			}
+		}
+		registrarEvento(EventoSeguranca{UserID: c.ID, Email: claims.Email, IP: ip, Tipo: eventoIdentidadeVinculada,
+			Detalhe: fmt.Sprintf("%v (%v)", claims.Iss, claims.Sub)})
+		return c, nil
+	} else if err != sql.ErrNoRows {
+		return c, err
+	}
+
+	nome := strings.TrimSpace(claims.Nome)
+	if !validarNome(nome) {
+		nome, _, _ = strings.Cut(claims.Email, "@")
+	}
+
+	c = ContaOIDC{ID: uuid.New().String(), Papel: PapelAluno}
+	tx, err := conn.Begin()
+	if err != nil {
+		return c, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("INSERT INTO users (id, email, nome, verificado) VALUES (?, ?, ?, ?)", c.ID, claims.Email, nome, bool(claims.EmailVerificado)); err != nil {
+		if ehChaveDuplicada(err) {
+			return c, errOIDCEmailEmUso
+		}
+		return c, err
+	}
+	if _, err := tx.Exec("INSERT INTO identidades (emissor, sub, user_id) VALUES (?, ?, ?)", claims.Iss, claims.Sub, c.ID); err != nil {
+		return c, err
+	}
+	if err := tx.Commit(); err != nil {
+		return c, err
+	}
+
+	if !claims.EmailVerificado {
+		if err := enviarVerificacao(c.ID, claims.Email); err != nil {
+			logger.Printf("[w] falha ao enviar verificação para %v: %v\n", c.ID, err)
+		}
+	}
+	return c, nil
+}
+
+// concluirReautenticacaoOIDC confere que a identidade é do usuário que pediu
+// a confirmação e que o login no provedor é de agora.
+func concluirReautenticacaoOIDC(w http.ResponseWriter, claims ClaimsOIDC, estado estadoOIDC) {
+	if claims.AuthTime < estado.Criado-int64(folgaRelogio.Seconds()) {
+		enviarErrorJson(w, "O provedor não pediu o login de novo", 401)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	var dono string
+	err = conn.QueryRow("SELECT user_id FROM identidades WHERE emissor = ? AND sub = ?", claims.Iss, claims.Sub).Scan(&dono)
+	if err != nil && err != sql.ErrNoRows {
+		logger.Println("[e] Erro ao buscar identidade OIDC:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	if dono != estado.UserID {
+		enviarErrorJson(w, "Esta identidade não está ligada à sua conta", 403)
+		return
+	}
+
+	reautenticacao, err := criarReautenticacao(estado.UserID)
+	if err != nil {
+		logger.Println("[e] Erro ao criar reautenticação:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	enviarRespostaJson(w, reautenticacao, 200)
+}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// provedorFalso é um provedor OIDC mínimo (descoberta, JWKS, authorize e
+// token com PKCE) para testar o login institucional sem um provedor de
+// verdade. O authorize aprova na hora o usuário em claims.
+type provedorFalso struct {
+	*httptest.Server
+	t      *testing.T
+	chave  *rsa.PrivateKey
+	claims map[string]any
+
+	mu      sync.Mutex
+	codigos map[string]pedidoFalso
+}
+
+type pedidoFalso struct {
+	desafio  string
+	nonce    string
+	redirect string
+}
+
+func novoProvedorFalso(t *testing.T) *provedorFalso {
+	t.Helper()
+	chave, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := &provedorFalso{t: t, chave: chave, codigos: map[string]pedidoFalso{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.descoberta)
+	mux.HandleFunc("/jwks", p.jwks)
+	mux.HandleFunc("/authorize", p.autorizar)
+	mux.HandleFunc("/token", p.token)
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Close)
+
+	p.claims = map[string]any{
+		"sub":            "aluno-1",
+		"email":          "aluno@ufu.br",
+		"email_verified": "true",
+		"name":           "Aluno de Teste",
+	}
+	return p
+}
+
+func (p *provedorFalso) descoberta(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode(DescobertaOIDC{
+		Issuer:                p.URL,
+		AuthorizationEndpoint: p.URL + "/authorize",
+		TokenEndpoint:         p.URL + "/token",
+		JWKSURI:               p.URL + "/jwks",
+	})
+}
+
+func (p *provedorFalso) jwks(w http.ResponseWriter, r *http.Request) {
+	pub := p.chave.PublicKey
+	json.NewEncoder(w).Encode(map[string]any{"keys": []JWK{{
+		Kty: "RSA",
+		Kid: "teste",
+		N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+	}}})
+}
+
+func (p *provedorFalso) autorizar(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("response_type") != "code" || q.Get("code_challenge_method") != "S256" {
+		http.Error(w, "pedido inválido", 400)
+		return
+	}
+
+	codigo, _ := gerarSegredo()
+	p.mu.Lock()
+	p.codigos[codigo] = pedidoFalso{desafio: q.Get("code_challenge"), nonce: q.Get("nonce"), redirect: q.Get("redirect_uri")}
+	p.mu.Unlock()
+
+	volta, _ := url.Parse(q.Get("redirect_uri"))
+	v := volta.Query()
+	v.Set("code", codigo)
+	v.Set("state", q.Get("state"))
+	volta.RawQuery = v.Encode()
+	http.Redirect(w, r, volta.String(), http.StatusFound)
+}
+
+func (p *provedorFalso) token(w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+
+	// o código só vale uma vez
+	p.mu.Lock()
+	pedido, ok := p.codigos[r.PostForm.Get("code")]
+	delete(p.codigos, r.PostForm.Get("code"))
+	p.mu.Unlock()
+
+	desafio := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok || r.PostForm.Get("grant_type") != "authorization_code" ||
+		r.PostForm.Get("redirect_uri") != pedido.redirect ||
+		base64.RawURLEncoding.EncodeToString(desafio[:]) != pedido.desafio {
+		w.WriteHeader(400)
+		json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	agora := time.Now().Unix()
+	claims := map[string]any{"iss": p.URL, "aud": "brain-quest", "iat": agora, "exp": agora + 300, "auth_time": agora, "nonce": pedido.nonce}
+	for k, v := range p.claims {
+		claims[k] = v
+	}
+	json.NewEncoder(w).Encode(map[string]string{"id_token": p.assinar(claims)})
+}
+
+func (p *provedorFalso) assinar(claims map[string]any) string {
+	cabecalho, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": "teste"})
+	corpo, _ := json.Marshal(claims)
+	assinado := base64.RawURLEncoding.EncodeToString(cabecalho) + "." + base64.RawURLEncoding.EncodeToString(corpo)
+
+	h := sha256.Sum256([]byte(assinado))
+	assinatura, err := rsa.SignPKCS1v15(rand.Reader, p.chave, crypto.SHA256, h[:])
+	if err != nil {
+		p.t.Fatal(err)
+	}
+	return assinado + "." + base64.RawURLEncoding.EncodeToString(assinatura)
+}
+
+// obterCodigo faz o papel do navegador: abre a URL de autorização e devolve o
+// code que voltaria para o redirect_uri.
+func (p *provedorFalso) obterCodigo(t *testing.T, d DescobertaOIDC, cliente *ProvedorOIDC, verificador, nonce string) string {
+	t.Helper()
+	desafio := sha256.Sum256([]byte(verificador))
+	q := url.Values{}
+	q.Set("response_type", "code")
+	q.Set("client_id", cliente.ClientID)
+	q.Set("redirect_uri", cliente.RedirectURI)
+	q.Set("state", "estado")
+	q.Set("nonce", nonce)
+	q.Set("code_challenge", base64.RawURLEncoding.EncodeToString(desafio[:]))
+	q.Set("code_challenge_method", "S256")
+
+	naoSeguir := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	resp, err := naoSeguir.Get(d.AuthorizationEndpoint + "?" + q.Encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	volta, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil || !strings.HasPrefix(volta.String(), cliente.RedirectURI) {
+		t.Fatalf("redirect inesperado: %q", resp.Header.Get("Location"))
+	}
+	return volta.Query().Get("code")
+}
+
+func clienteDoProvedorFalso(p *provedorFalso) *ProvedorOIDC {
+	return &ProvedorOIDC{
+		Emissor:     p.URL,
+		ClientID:    "brain-quest",
+		RedirectURI: "http://localhost:5173/login/oidc",
+		Escopos:     "openid email profile",
+		cliente:     p.Client(),
+	}
+}
+
+func TestLoginOIDCComProvedorFalso(t *testing.T) {
+	p := novoProvedorFalso(t)
+	cliente := clienteDoProvedorFalso(p)
+
+	d, err := cliente.descobrir()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	codigo := p.obterCodigo(t, d, cliente, "verificador", "nonce")
+	idToken, err := cliente.trocarCodigo(d, codigo, "verificador")
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims, err := cliente.verificarIDToken(d, idToken, "nonce")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if claims.Iss != p.URL || claims.Sub != "aluno-1" || claims.Email != "aluno@ufu.br" || !bool(claims.EmailVerificado) {
+		t.Errorf("claims inesperadas: %+v", claims)
+	}
+	if claims.AuthTime == 0 {
+		t.Error("auth_time não foi lido")
+	}
+
+	// o mesmo código não pode ser trocado de novo
+	if _, err := cliente.trocarCodigo(d, codigo, "verificador"); !errors.Is(err, errCodigoOIDCRecusa) {
+		t.Errorf("código reusado: erro %v, esperava errCodigoOIDCRecusa", err)
+	}
+}
+
+func TestLoginOIDCRecusado(t *testing.T) {
+	casos := []struct {
+		nome    string
+		claims  map[string]any
+		trocar  string // verificador mandado no token endpoint
+		nonce   string // nonce conferido no id_token
+		esperar error
+	}{
+		{nome: "verificador PKCE errado", trocar: "outro", nonce: "nonce", esperar: errCodigoOIDCRecusa},
+		{nome: "nonce errado", trocar: "verificador", nonce: "outro", esperar: errIDTokenInvalido},
+		{nome: "outra audiência", claims: map[string]any{"aud": "outro-app"}, trocar: "verificador", nonce: "nonce", esperar: errIDTokenInvalido},
+		{nome: "outro emissor", claims: map[string]any{"iss": "https://outro.example"}, trocar: "verificador", nonce: "nonce", esperar: errIDTokenInvalido},
+		{nome: "expirado", claims: map[string]any{"exp": time.Now().Add(-time.Hour).Unix()}, trocar: "verificador", nonce: "nonce", esperar: errIDTokenInvalido},
+		{nome: "sem sub", claims: map[string]any{"sub": ""}, trocar: "verificador", nonce: "nonce", esperar: errIDTokenInvalido},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			p := novoProvedorFalso(t)
+			for k, v := range c.claims {
+				p.claims[k] = v
+			}
+			cliente := clienteDoProvedorFalso(p)
+
+			d, err := cliente.descobrir()
+			if err != nil {
+				t.Fatal(err)
+			}
+			codigo := p.obterCodigo(t, d, cliente, "verificador", "nonce")
+
+			idToken, err := cliente.trocarCodigo(d, codigo, c.trocar)
+			if err == nil {
+				_, err = cliente.verificarIDToken(d, idToken, c.nonce)
+			}
+			if !errors.Is(err, c.esperar) {
+				t.Errorf("erro %v, esperava %v", err, c.esperar)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+)
+
+// Papéis dos usuários. O papel vai no token (claim "role") e as rotas
+// privilegiadas declaram quais papéis aceitam em protegida(...).
+
+type Papel string
+
+const (
+	PapelAdmin     Papel = "admin"
+	PapelProfessor Papel = "teacher"
+	PapelAluno     Papel = "student"
+)
+
+var papeis = []Papel{PapelAdmin, PapelProfessor, PapelAluno}
+
+func papelValido(p Papel) bool {
+	return slices.Contains(papeis, p)
+}
+
+func buscarPapel(conn *sql.DB, userID string) (Papel, error) {
+	var papel Papel
+	err := conn.QueryRow("SELECT papel FROM users WHERE id = ?", userID).Scan(&papel)
+	return papel, err
+}
+
+// definirPapel troca o papel e encerra as sessões do usuário, para que os
+// tokens com o papel antigo deixem de valer.
+func definirPapel(conn *sql.DB, userID string, papel Papel) error {
+	res, err := conn.Exec("UPDATE users SET papel = ? WHERE id = ?", papel, userID)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		var existe bool
+		if err := conn.QueryRow("SELECT EXISTS(SELECT 1 FROM users WHERE id = ?)", userID).Scan(&existe); err != nil {
+			return err
+		}
+		if !existe {
+			return sql.ErrNoRows
+		}
+	}
+
+	return revogarTodasFamilias(userID)
+}
+
+type PapelData struct {
+	Role Papel `json:"role"`
+}
+
+func alterarPapelUsuario(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodPut {
+		w.WriteHeader(406)
+		return
+	}
+	userID := r.PathValue("id")
+
+	var dados PapelData
+
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	err := d.Decode(&dados)
+
+	if err != nil || d.More() {
+		enviarErrorJson(w, "Estrutura do JSON incorreta.", 400)
+		return
+	}
+
+	if !papelValido(dados.Role) {
+		enviarErrorJson(w, fmt.Sprintf("Papel inválido, use um destes: %v", papeis), 400)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	err = definirPapel(conn, userID, dados.Role)
+	if err == sql.ErrNoRows {
+		enviarErrorJson(w, "Usuário inexistente", 404)
+		return
+	} else if err != nil {
+		logger.Printf("[e] Erro ao alterar papel de %v: %v\n", userID, err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	enviarRespostaJson(w, "ok", 200)
+}
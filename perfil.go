@@ -0,0 +1,361 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/paemuri/brdoc"
+	"github.com/redis/go-redis/v9"
+)
+
+// Edição do perfil: nome e telefone mudam direto pelo PATCH /user/info; o
+// email só é trocado depois que o endereço novo for confirmado. Contas
+// criadas pelo login institucional informam o CPF uma vez, em
+// POST /user/profile/complete.
+
+const duracaoTrocaEmail = 24 * time.Hour
+
+func chaveTrocaEmail(hash string) string {
+	return fmt.Sprintf("troca_email:%s", hash)
+}
+
+type AtualizarPerfilData struct {
+	Nome     *string `json:"nome,omitempty"`
+	Telefone *string `json:"telefone,omitempty"`
+}
+
+// normalizarTelefone deixa só os dígitos. Telefone vazio remove o número.
+func normalizarTelefone(telefone string) (*string, bool) {
+	digitos := strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, telefone)
+
+	if digitos == "" {
+		return nil, strings.TrimSpace(telefone) == ""
+	}
+	if len(digitos) < 10 || len(digitos) > 13 {
+		return nil, false
+	}
+	return &digitos, true
+}
+
+func validarNome(nome string) bool {
+	n := utf8.RuneCountInString(nome)
+	return n >= 2 && n <= 255
+}
+
+func atualizarPerfil(w http.ResponseWriter, r *http.Request) {
+	usuario := usuarioDoContexto(r)
+	var dados AtualizarPerfilData
+
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	err := d.Decode(&dados)
+
+	if err != nil || d.More() || (dados.Nome == nil && dados.Telefone == nil) {
+		enviarErrorJson(w, "Estrutura do JSON incorreta.", 400)
+		return
+	}
+
+	var campos []string
+	var valores []any
+
+	if dados.Nome != nil {
+		nome := strings.TrimSpace(*dados.Nome)
+		if !validarNome(nome) {
+			enviarErrorJson(w, "Nome deve ter entre 2 e 255 caracteres", 400)
+			return
+		}
+		campos = append(campos, "nome = ?")
+		valores = append(valores, nome)
+	}
+
+	if dados.Telefone != nil {
+		telefone, ok := normalizarTelefone(*dados.Telefone)
+		if !ok {
+			enviarErrorJson(w, "Telefone inválido, use DDD + número", 400)
+			return
+		}
+		campos = append(campos, "telefone = ?")
+		valores = append(valores, telefone)
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	valores = append(valores, usuario.UUID)
+	if _, err := conn.Exec("UPDATE users SET "+strings.Join(campos, ", ")+" WHERE id = ?", valores...); err != nil {
+		logger.Printf("[e] Erro ao atualizar perfil de %v: %v\n", usuario.UUID, err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	userData := getUserData(usuario.UUID)
+	if userData.Status != 200 {
+		enviarErrorJson(w, userData.Message, userData.Status)
+		return
+	}
+
+	enviarRespostaJson(w, userData.User, 200)
+}
+
+type CompletarPerfilData struct {
+	Cpf      string  `json:"cpf"`
+	Telefone *string `json:"telefone,omitempty"`
+}
+
+func completarPerfil(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodPost {
+		w.WriteHeader(406)
+		return
+	}
+	usuario := usuarioDoContexto(r)
+	var dados CompletarPerfilData
+
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	err := d.Decode(&dados)
+
+	if err != nil || d.More() || dados.Cpf == "" {
+		enviarErrorJson(w, "Estrutura do JSON incorreta.", 400)
+		return
+	}
+
+	if !brdoc.IsCPF(dados.Cpf) {
+		enviarErrorJson(w, "CPF inválido", 400)
+		return
+	}
+
+	campos := []string{"cpf_cifrado = ?", "cpf_indice = ?"}
+	cpf := normalizarCPF(dados.Cpf)
+	cpfCifrado, err := cifrar(cpf)
+	if err != nil {
+		logger.Println("[e] Erro ao cifrar CPF:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	valores := []any{cpfCifrado, indiceCego(cpf)}
+
+	if dados.Telefone != nil {
+		telefone, ok := normalizarTelefone(*dados.Telefone)
+		if !ok {
+			enviarErrorJson(w, "Telefone inválido, use DDD + número", 400)
+			return
+		}
+		campos = append(campos, "telefone = ?")
+		valores = append(valores, telefone)
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	// o CPF não muda depois de informado
+	valores = append(valores, usuario.UUID)
+	res, err := conn.Exec("UPDATE users SET "+strings.Join(campos, ", ")+" WHERE id = ? AND cpf_cifrado IS NULL", valores...)
+	if ehChaveDuplicada(err) {
+		enviarErrorJson(w, "CPF já cadastrado em outra conta", 409)
+		return
+	} else if err != nil {
+		logger.Printf("[e] Erro ao completar perfil de %v: %v\n", usuario.UUID, err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		enviarErrorJson(w, "O perfil já está completo", 409)
+		return
+	}
+	invalidarCacheUsuario(usuario.UUID)
+
+	userData := getUserData(usuario.UUID)
+	if userData.Status != 200 {
+		enviarErrorJson(w, userData.Message, userData.Status)
+		return
+	}
+
+	enviarRespostaJson(w, userData.User, 200)
+}
+
+type TrocaEmailData struct {
+	Email          string `json:"email"`
+	Senha          string `json:"senha"`
+	Reautenticacao string `json:"reauth_token"`
+}
+
+type trocaEmailPendente struct {
+	UserID string `json:"uid"`
+	Email  string `json:"email"`
+}
+
+func trocarEmail(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodPost {
+		w.WriteHeader(406)
+		return
+	}
+	usuario := usuarioDoContexto(r)
+	var dados TrocaEmailData
+
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	err := d.Decode(&dados)
+
+	if err != nil || d.More() || (dados.Senha == "" && dados.Reautenticacao == "") {
+		enviarErrorJson(w, "Estrutura do JSON incorreta.", 400)
+		return
+	}
+
+	if _, err := mail.ParseAddress(dados.Email); err != nil {
+		enviarErrorJson(w, "Email inválido", 400)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	// com o email trocado, a recuperação de senha vai para o endereço novo;
+	// por isso só a sessão não basta
+	var senhaSalva, emailAntigo string
+	err = conn.QueryRow("SELECT COALESCE(senha, ''), email FROM users WHERE id = ?", usuario.UUID).Scan(&senhaSalva, &emailAntigo)
+	if err == sql.ErrNoRows {
+		enviarErrorJson(w, "O usuário não existe mais", 401)
+		return
+	} else if err != nil {
+		logger.Println("[e] Erro ao buscar usuário:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	if err := confirmarIdentidade(usuario.UUID, emailAntigo, ipCliente(r), senhaSalva, dados.Senha, dados.Reautenticacao); err != nil {
+		enviarErroIdentidade(w, err, "Senha incorreta")
+		return
+	}
+
+	var emUso bool
+	if err := conn.QueryRow("SELECT EXISTS(SELECT 1 FROM users WHERE email = ?)", dados.Email).Scan(&emUso); err != nil {
+		logger.Println("[e] Erro ao buscar email:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	if emUso {
+		enviarErrorJson(w, "Email já cadastrado", http.StatusConflict)
+		return
+	}
+
+	segredo, err := gerarSegredo()
+	if err != nil {
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	pendente, _ := json.Marshal(trocaEmailPendente{UserID: usuario.UUID, Email: dados.Email})
+	chave := chaveTrocaEmail(hashSegredo(segredo))
+	pipe := rdb.TxPipeline()
+	pipe.Set(ctx, chave, pendente, duracaoTrocaEmail)
+	indexarToken(pipe, usuario.UUID, chave, duracaoTrocaEmail)
+	if _, err := pipe.Exec(ctx); err != nil {
+		logger.Println("[e] Erro ao salvar troca de email:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	enviarEmail(Mensagem{
+		Para:    dados.Email,
+		Assunto: "Brain Quest - Confirme seu novo email",
+		Corpo: fmt.Sprintf("Recebemos um pedido para usar este endereço na sua conta do Brain Quest.\n\n"+
+			"Para confirmar, acesse:\n%s\n\n"+
+			"O link vale por %v horas. Até lá, o email antigo continua valendo.\n",
+			linkFrontend("/email/confirm?token=%s", segredo), duracaoTrocaEmail.Hours()),
+	})
+	enviarEmail(Mensagem{
+		Para:    emailAntigo,
+		Assunto: "Brain Quest - Pedido de troca de email",
+		Corpo: fmt.Sprintf("Recebemos um pedido para trocar o email da sua conta do Brain Quest para %s.\n\n"+
+			"A troca só acontece quando o endereço novo for confirmado. Se não foi você, troque sua senha e encerre suas sessões.\n", dados.Email),
+	})
+
+	enviarRespostaJson(w, "ok", 202)
+}
+
+func confirmarTrocaEmail(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodGet {
+		w.WriteHeader(406)
+		return
+	}
+	token := r.PathValue("token")
+	if token == "" {
+		enviarErrorJson(w, "Token de confirmação vazio", 400)
+		return
+	}
+
+	bruto, err := rdb.GetDel(ctx, chaveTrocaEmail(hashSegredo(token))).Result()
+	if err == redis.Nil {
+		enviarErrorJson(w, "Token de confirmação inválido ou expirado", 401)
+		return
+	} else if err != nil {
+		logger.Println("[e] Erro ao buscar troca de email:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	var pendente trocaEmailPendente
+	if err := json.Unmarshal([]byte(bruto), &pendente); err != nil {
+		logger.Println("[e] Troca de email corrompida:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	var emailAntigo string
+	if err := conn.QueryRow("SELECT email FROM users WHERE id = ?", pendente.UserID).Scan(&emailAntigo); err != nil {
+		enviarErrorJson(w, "Usuário inexistente", 404)
+		return
+	}
+
+	// o endereço novo acabou de ser confirmado, então a conta fica verificada
+	_, err = conn.Exec("UPDATE users SET email = ?, verificado = TRUE WHERE id = ?", pendente.Email, pendente.UserID)
+	if ehChaveDuplicada(err) {
+		enviarErrorJson(w, "Email já cadastrado", http.StatusConflict)
+		return
+	} else if err != nil {
+		logger.Printf("[e] Erro ao trocar email de %v: %v\n", pendente.UserID, err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	invalidarCacheUsuario(pendente.UserID)
+
+	enviarEmail(Mensagem{
+		Para:    emailAntigo,
+		Assunto: "Brain Quest - Seu email foi alterado",
+		Corpo: fmt.Sprintf("O email da sua conta do Brain Quest foi trocado para %s.\n\n"+
+			"Se não foi você, responda este email.\n", pendente.Email),
+	})
+
+	enviarRespostaJson(w, "ok", 200)
+}
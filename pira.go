@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Leitura do dataset Pirá (Paschoal et al., 2021), de onde vieram as questões
+// do populate.sql. O dataset é publicado como uma lista JSON ou JSON Lines,
+// com muitos campos de anotação que aqui são ignorados. Os que interessam:
+//
+//	question_en_origin (ou question, question_pt_origin)  -> pergunta
+//	A a E e correct (versão de múltipla escolha)           -> alternativas
+//	answer_en_validate (ou answer_en_origin, answer_pt_*)  -> explicacao
+//	abstract (ou abstract_translated_pt) e eid_article_scopus -> referência
+//
+// As perguntas abertas (sem A a E) não têm como ser corrigidas e viram
+// registros inválidos no relatório.
+
+const (
+	formatoPira = "pira"
+
+	fontePira      = "Pirá"
+	atribuicaoPira = "Pirá: A Bilingual Portuguese-English Dataset for Question-Answering about the Ocean (Paschoal et al., 2021), CC BY 4.0"
+)
+
+var (
+	camposPerguntaPira    = []string{"question_en_origin", "question", "question_pt_origin"}
+	camposRespostaPira    = []string{"answer_en_validate", "answer_en_origin", "answer", "answer_pt_validate", "answer_pt_origin"}
+	camposAbstractPira    = []string{"abstract", "text", "abstract_translated_pt"}
+	camposAlternativaPira = []string{"A", "B", "C", "D", "E"}
+)
+
+// textoPira devolve o primeiro dos campos que estiver preenchido.
+func textoPira(campos map[string]any, nomes ...string) string {
+	for _, nome := range nomes {
+		var texto string
+		switch v := campos[nome].(type) {
+		case string:
+			texto = v
+		case float64:
+			texto = strconv.FormatFloat(v, 'f', -1, 64)
+		}
+		if texto = strings.TrimSpace(texto); texto != "" {
+			return texto
+		}
+	}
+	return ""
+}
+
+// registroPira converte um registro do Pirá.
+func registroPira(linha int, campos map[string]any) (RegistroImportacao, error) {
+	reg := RegistroImportacao{Linha: linha}
+	q := &reg.Questao
+
+	q.Pergunta = textoPira(campos, camposPerguntaPira...)
+	for _, letra := range camposAlternativaPira {
+		reg.Letras = append(reg.Letras, textoPira(campos, letra, strings.ToLower(letra)))
+	}
+	reg.Correta = textoPira(campos, "correct")
+	if reg.Correta == "" {
+		return reg, fmt.Errorf("pergunta aberta do Pirá, sem alternativas A a E e correct: não tem como ser corrigida")
+	}
+
+	if resposta := textoPira(campos, camposRespostaPira...); resposta != "" {
+		q.Explicacao = &resposta
+	}
+	if abstract := textoPira(campos, camposAbstractPira...); abstract != "" {
+		ref := Referencia{Trecho: abstract}
+		if eid := textoPira(campos, "eid_article_scopus"); eid != "" {
+			fonte := "Scopus " + eid
+			url := "https://www.scopus.com/inward/record.uri?eid=" + eid
+			ref.Fonte, ref.URL = &fonte, &url
+		}
+		q.Referencias = []Referencia{ref}
+	}
+
+	fonte, atribuicao := fontePira, atribuicaoPira
+	q.Fonte, q.Atribuicao = &fonte, &atribuicao
+
+	return reg, reg.finalizar()
+}
+
+// lerQuestoesPira aceita o arquivo como lista JSON ou JSON Lines. Na lista,
+// a "linha" do relatório é a posição do registro.
+func lerQuestoesPira(r io.Reader) ([]RegistroImportacao, []ProblemaImportacao, error) {
+	var registros []RegistroImportacao
+	var problemas []ProblemaImportacao
+
+	adicionar := func(linha int, campos map[string]any) {
+		reg, err := registroPira(linha, campos)
+		if err != nil {
+			problemas = append(problemas, ProblemaImportacao{Linha: linha, Tipo: "invalida", Mensagem: err.Error()})
+			return
+		}
+		registros = append(registros, reg)
+	}
+
+	dados, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(dados), []byte("[")) {
+		var lista []map[string]any
+		if err := json.Unmarshal(dados, &lista); err != nil {
+			return nil, nil, err
+		}
+		for i, campos := range lista {
+			adicionar(i+1, campos)
+		}
+		return registros, problemas, nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(dados))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for linha := 1; scanner.Scan(); linha++ {
+		texto := strings.TrimSpace(scanner.Text())
+		if texto == "" {
+			continue
+		}
+		var campos map[string]any
+		if err := json.Unmarshal([]byte(texto), &campos); err != nil {
+			problemas = append(problemas, ProblemaImportacao{Linha: linha, Tipo: "invalida", Mensagem: "JSON inválido: " + err.Error()})
+			continue
+		}
+		adicionar(linha, campos)
+	}
+	return registros, problemas, scanner.Err()
+}
@@ -10,13 +10,27 @@ import (
 )
 
 type Pergunta struct {
-	Pergunta     string `json:"pergunta"`
+	Pergunta     string                `json:"pergunta"`
+	Tipo         TipoQuestao           `json:"tipo,omitempty"`
+	Alternativas []AlternativaPergunta `json:"alternativas,omitempty"`
+
+	// formato antigo, só para escolha única e verdadeiro ou falso com até cinco alternativas
 	AlternativaA string `json:"alternativa_a,omitempty"`
 	AlternativaB string `json:"alternativa_b,omitempty"`
 	AlternativaC string `json:"alternativa_c,omitempty"`
 	AlternativaD string `json:"alternativa_d,omitempty"`
 	AlternativaE string `json:"alternativa_e,omitempty"`
 	Resposta     string `json:"resposta,omitempty"`
+
+	// só depois de responder
+	Explicacao  *string      `json:"explicacao,omitempty"`
+	Referencias []Referencia `json:"referencias,omitempty"`
+
+	Topicos     []Topico `json:"topicos,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	Fonte       *string  `json:"fonte,omitempty"`
+	Atribuicao  *string  `json:"atribuicao,omitempty"`
+	Dificuldade *string  `json:"dificuldade,omitempty"`
 }
 
 func buscarQuestaoId(w http.ResponseWriter, r *http.Request) {
@@ -30,11 +44,6 @@ func buscarQuestaoId(w http.ResponseWriter, r *http.Request) {
 		enviarErrorJson(w, "ID da pergunta vazio", 400)
 		return
 	}
-	uid := getUserUUID(r)
-	if uid.Status != 200 {
-		enviarErrorJson(w, "Token de autorização inválida", 403)
-		return
-	}
 
 	conn, err := OpenConn()
 	if err != nil {
@@ -43,19 +52,7 @@ func buscarQuestaoId(w http.ResponseWriter, r *http.Request) {
 	}
 	defer conn.Close()
 
-	var exists bool
-	err = conn.QueryRow("SELECT EXISTS(SELECT 1 FROM users WHERE id = ?)", uid.UUID).Scan(&exists)
-	if err != nil {
-		enviarErrorJson(w, "Usuário não existe", 404)
-		logger.Println("[e] Erro ao buscar usuário:", err)
-		return
-	}
-	if !exists {
-		enviarErrorJson(w, "Usuário inexistente", 404)
-		return
-	}
-	var pergunta Pergunta
-	err = conn.QueryRow("SELECT pergunta, alternativa_a, alternativa_b, alternativa_c, alternativa_d, alternativa_e FROM questoes WHERE id = ?", qid).Scan(&pergunta.Pergunta, &pergunta.AlternativaA, &pergunta.AlternativaB, &pergunta.AlternativaC, &pergunta.AlternativaD, &pergunta.AlternativaE)
+	pergunta, err := carregarPergunta(conn, qid, usuarioDoContexto(r).UUID)
 	if err == sql.ErrNoRows {
 		enviarErrorJson(w, "ID da pergunta incorreto", 401)
 		return
@@ -68,8 +65,42 @@ func buscarQuestaoId(w http.ResponseWriter, r *http.Request) {
 	enviarRespostaJson(w, pergunta, 200)
 }
 
+// carregarPergunta busca a questão como o usuário a vê, com as alternativas
+// na ordem dele e sem a resposta.
+func carregarPergunta(conn *sql.DB, qid int, userID string) (Pergunta, error) {
+	var pergunta Pergunta
+	err := conn.QueryRow("SELECT pergunta, tipo, fonte, atribuicao, dificuldade FROM questoes WHERE id = ? AND removida_em IS NULL", qid).Scan(&pergunta.Pergunta, &pergunta.Tipo, &pergunta.Fonte, &pergunta.Atribuicao, &pergunta.Dificuldade)
+	if err != nil {
+		return pergunta, err
+	}
+
+	alternativas, err := carregarAlternativas(conn, []int{qid})
+	if err != nil {
+		return pergunta, err
+	}
+	ordem := ordemAlternativas(userID, qid, pergunta.Tipo, len(alternativas[qid]))
+	pergunta.mostrarAlternativas(emOrdem(alternativas[qid], ordem))
+
+	topicos, err := topicosDasQuestoes(conn, []int{qid})
+	if err != nil {
+		return pergunta, err
+	}
+	_, tags, err := carregarClassificacao(conn, []int{qid})
+	if err != nil {
+		return pergunta, err
+	}
+	pergunta.Topicos, pergunta.Tags = topicos[qid], tags[qid]
+	return pergunta, nil
+}
+
+// RespostaQuiz traz o campo do tipo da questão: alternativa (escolha única e
+// verdadeiro ou falso), alternativas (múltipla escolha), valor (numérica) ou
+// texto (resposta curta).
 type RespostaQuiz struct {
-	Alternativa string `json:"alternativa"`
+	Alternativa  string   `json:"alternativa"`
+	Alternativas []string `json:"alternativas"`
+	Valor        *float64 `json:"valor"`
+	Texto        string   `json:"texto"`
 }
 
 func responderQuestaoId(w http.ResponseWriter, r *http.Request) {
@@ -83,9 +114,11 @@ func responderQuestaoId(w http.ResponseWriter, r *http.Request) {
 		enviarErrorJson(w, "ID da pergunta vazio", 400)
 		return
 	}
-	uid := getUserUUID(r)
-	if uid.Status != 200 {
-		enviarErrorJson(w, "Token de autorização inválida", 403)
+	uid := usuarioDoContexto(r)
+
+	versao, ok := versaoDaRequisicao(r)
+	if !ok {
+		enviarErrorJson(w, "Versão da API desconhecida", 400)
 		return
 	}
 
@@ -100,15 +133,6 @@ func responderQuestaoId(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	verificarIfDone, err := usuarioJaFez(uid.UUID, qid)
-	if err != nil {
-		logger.Printf("[w] Não foi possível verificar se %v já fez a questão %v: %v\n", uid.UUID, qid, err)
-	}
-	if verificarIfDone {
-		enviarErrorJson(w, "Usuário já respondeu essa pergunta", 409)
-		return
-	}
-
 	conn, err := OpenConn()
 	if err != nil {
 		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
@@ -116,19 +140,7 @@ func responderQuestaoId(w http.ResponseWriter, r *http.Request) {
 	}
 	defer conn.Close()
 
-	var exists bool
-	err = conn.QueryRow("SELECT EXISTS(SELECT 1 FROM users WHERE id = ?)", uid.UUID).Scan(&exists)
-	if err != nil {
-		enviarErrorJson(w, "Usuário não existe", 404)
-		logger.Println("[e] Erro ao buscar usuário:", err)
-		return
-	}
-	if !exists {
-		enviarErrorJson(w, "Usuário inexistente", 404)
-		return
-	}
-	var pergunta Pergunta
-	err = conn.QueryRow("SELECT pergunta, correta FROM questoes WHERE id = ?", questionID).Scan(&pergunta.Pergunta, &pergunta.Resposta)
+	questao, err := buscarQuestaoAdmin(conn, qid)
 	if err == sql.ErrNoRows {
 		enviarErrorJson(w, "ID da pergunta incorreto", 401)
 		return
@@ -138,31 +150,42 @@ func responderQuestaoId(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	acertou := dadosResposta.Alternativa == pergunta.Resposta
-
-	sqlUpdate := `
-    UPDATE dados
-    SET 
-      quest_feitas = quest_feitas + 1,
-      alternativas_acertas = alternativas_acertas + ?,
-      alternativas_erradas = alternativas_erradas + ?
-    WHERE id = ?;
-`
+	// a questão é marcada como feita antes de corrigir e pontuar, para duas
+	// respostas ao mesmo tempo não somarem XP duas vezes
+	reservou, err := reservarQuestao(uid.UUID, qid)
+	if err != nil {
+		logger.Printf("[e] Não foi possível marcar a questão %v como feita por %v: %v\n", qid, uid.UUID, err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	if !reservou {
+		enviarErrorJson(w, "Usuário já respondeu essa pergunta", 409)
+		return
+	}
 
-	acertos := 0
-	erros := 0
-	if acertou {
-		acertos = 1
-	} else {
-		erros = 1
+	// as letras vêm na ordem em que o usuário viu as alternativas
+	ordem := ordemAlternativas(uid.UUID, qid, questao.Tipo, len(questao.Alternativas))
+	acertou, msg := corrigir(questao.QuestaoData, dadosResposta.naOrdemOriginal(ordem))
+	if msg != "" {
+		liberarQuestao(uid.UUID, qid)
+		enviarErrorJson(w, msg, 400)
+		return
 	}
+	vista := questao.QuestaoData
+	vista.Alternativas = emOrdem(questao.Alternativas, ordem)
 
-	if _, err := conn.Exec(sqlUpdate, acertos, erros, uid.UUID); err != nil {
-		logger.Printf("[w] falha ao atualizar os dados de %v: %v\n", uid, err)
+	resultado, err := atualizarPlacar(conn, uid.UUID, acertou)
+	if err != nil {
+		logger.Printf("[e] falha ao atualizar os dados de %v: %v\n", uid.UUID, err)
+		liberarQuestao(uid.UUID, qid)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
 	}
 
-	if err := registrarResposta(uid.UUID, qid, acertou); err != nil {
-		logger.Printf("[w] falha ao atualizar Redis de %v: %v\n", uid.UUID, err)
+	if acertou {
+		if err := registrarAcerto(uid.UUID, qid); err != nil {
+			logger.Printf("[w] falha ao registrar o acerto de %v na questão %v: %v\n", uid.UUID, qid, err)
+		}
 	}
 
 	qzId := r.Header.Get("X-Quiz-ID")
@@ -183,12 +206,25 @@ func responderQuestaoId(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	if !acertou {
-		enviarRespostaJson(w, pergunta, 204)
+	if versao == versaoAPIStatus {
+		// formato antigo: o erro é só o status, a explicação fica na versão 2
+		if !acertou {
+			w.WriteHeader(204)
+			return
+		}
+		pergunta := Pergunta{Pergunta: questao.Pergunta, Resposta: respostaCorreta(vista), Explicacao: questao.Explicacao, Referencias: questao.Referencias}
+		enviarRespostaJson(w, pergunta, 202)
 		return
 	}
 
-	enviarRespostaJson(w, pergunta, 202)
+	resultado.Versao = versaoAPIAtual
+	resultado.Correta = acertou
+	resultado.AlternativaCorreta = respostaCorreta(vista)
+	resultado.AlternativaEscolhida = respostaEscolhida(questao.Tipo, dadosResposta)
+	resultado.Pergunta = questao.Pergunta
+	resultado.Explicacao = questao.Explicacao
+	resultado.Referencias = append([]Referencia{}, questao.Referencias...)
+	enviarRespostaJson(w, resultado, 200)
 }
 
 func usuarioJaFez(userID string, questaoID int) (bool, error) {
@@ -226,21 +262,24 @@ func registrarQuiz(userID string, quizID int) error {
 	return nil
 }
 
-func registrarResposta(userID string, questaoID int, acertou bool) error {
-	keyFeitas := fmt.Sprintf("user:%s:feitas", userID)
-	keyAcertos := fmt.Sprintf("user:%s:acertos", userID)
-
-	//add a feitas
-	if err := rdb.SAdd(ctx, keyFeitas, questaoID).Err(); err != nil {
-		return err
-	}
+// reservarQuestao marca a questão como feita e diz se foi esta chamada que
+// marcou; false quer dizer que o usuário já tinha respondido.
+func reservarQuestao(userID string, questaoID int) (bool, error) {
+	key := fmt.Sprintf("user:%s:feitas", userID)
+	n, err := rdb.SAdd(ctx, key, questaoID).Result()
+	return n == 1, err
+}
 
-	//add a acertadas
-	if acertou {
-		if err := rdb.SAdd(ctx, keyAcertos, questaoID).Err(); err != nil {
-			return err
-		}
+// liberarQuestao desfaz reservarQuestao quando a resposta não chegou a ser
+// contada, para o usuário poder tentar de novo.
+func liberarQuestao(userID string, questaoID int) {
+	key := fmt.Sprintf("user:%s:feitas", userID)
+	if err := rdb.SRem(ctx, key, questaoID).Err(); err != nil {
+		logger.Printf("[e] falha ao desmarcar a questão %v de %v: %v\n", questaoID, userID, err)
 	}
+}
 
-	return nil
+func registrarAcerto(userID string, questaoID int) error {
+	key := fmt.Sprintf("user:%s:acertos", userID)
+	return rdb.SAdd(ctx, key, questaoID).Err()
 }
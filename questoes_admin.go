@@ -0,0 +1,569 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Edição do banco de questões por admins ou por integrações com o escopo
+// questions:write. A exclusão só marca removida_em: a questão some das buscas,
+// mas continua no banco para o histórico de quem já respondeu.
+
+// letras das alternativas, na ordem em que são mostradas
+var letrasAlternativas = []string{"A", "B", "C", "D", "E", "F", "G", "H", "I", "J"}
+
+const minimoAlternativas = 2
+
+var dificuldades = []string{"facil", "media", "dificil"}
+
+type AlternativaQuestao struct {
+	Texto   string `json:"texto"`
+	Correta bool   `json:"correta"`
+}
+
+type QuestaoData struct {
+	Pergunta         string               `json:"pergunta"`
+	Tipo             TipoQuestao          `json:"tipo"`
+	Alternativas     []AlternativaQuestao `json:"alternativas,omitempty"`
+	RespostaNumerica *float64             `json:"resposta_numerica,omitempty"`
+	Tolerancia       *float64             `json:"tolerancia,omitempty"`
+	RespostasAceitas []string             `json:"respostas_aceitas,omitempty"`
+	Explicacao       *string              `json:"explicacao"`
+	Referencias      []Referencia         `json:"referencias"`
+	Fonte            *string              `json:"fonte"`
+	Atribuicao       *string              `json:"atribuicao"`
+	Dificuldade      *string              `json:"dificuldade"`
+	Topicos          []string             `json:"topicos"`
+	Tags             []string             `json:"tags"`
+}
+
+type Questao struct {
+	ID int `json:"id"`
+	QuestaoData
+}
+
+// EntradaQuestao é o corpo de POST e PUT. Além do formato novo aceita o
+// antigo, com alternativa_a a alternativa_e e a letra em correta, que vira
+// uma questão do tipo unica.
+type EntradaQuestao struct {
+	QuestaoData
+	AlternativaA string `json:"alternativa_a"`
+	AlternativaB string `json:"alternativa_b"`
+	AlternativaC string `json:"alternativa_c"`
+	AlternativaD string `json:"alternativa_d"`
+	AlternativaE string `json:"alternativa_e"`
+	Correta      string `json:"correta"`
+}
+
+type PatchQuestaoData struct {
+	Pergunta         *string               `json:"pergunta,omitempty"`
+	Tipo             *TipoQuestao          `json:"tipo,omitempty"`
+	Alternativas     *[]AlternativaQuestao `json:"alternativas,omitempty"`
+	RespostaNumerica *float64              `json:"resposta_numerica,omitempty"`
+	Tolerancia       *float64              `json:"tolerancia,omitempty"`
+	RespostasAceitas *[]string             `json:"respostas_aceitas,omitempty"`
+	Explicacao       *string               `json:"explicacao,omitempty"`
+	Referencias      *[]Referencia         `json:"referencias,omitempty"`
+	AlternativaA     *string               `json:"alternativa_a,omitempty"`
+	AlternativaB     *string               `json:"alternativa_b,omitempty"`
+	AlternativaC     *string               `json:"alternativa_c,omitempty"`
+	AlternativaD     *string               `json:"alternativa_d,omitempty"`
+	AlternativaE     *string               `json:"alternativa_e,omitempty"`
+	Correta          *string               `json:"correta,omitempty"`
+	Fonte            *string               `json:"fonte,omitempty"`
+	Atribuicao       *string               `json:"atribuicao,omitempty"`
+	Dificuldade      *string               `json:"dificuldade,omitempty"`
+	Topicos          *[]string             `json:"topicos,omitempty"`
+	Tags             *[]string             `json:"tags,omitempty"`
+}
+
+// indiceLetra devolve a posição da letra (A = 0), ou -1.
+func indiceLetra(letra string) int {
+	return slices.Index(letrasAlternativas, strings.ToUpper(strings.TrimSpace(letra)))
+}
+
+// alternativasDasLetras monta as alternativas do formato plano (uma coluna
+// por letra e as letras corretas em correta, separadas por | ou vírgula),
+// usado pelo formato antigo da API e pela importação. textos vazios no fim
+// são ignorados; no meio, não.
+func alternativasDasLetras(textos []string, correta string) ([]AlternativaQuestao, string) {
+	ultima := -1
+	for i, t := range textos {
+		if strings.TrimSpace(t) != "" {
+			ultima = i
+		}
+	}
+
+	alternativas := make([]AlternativaQuestao, ultima+1)
+	for i := range alternativas {
+		alternativas[i].Texto = textos[i]
+	}
+	for _, letra := range strings.FieldsFunc(correta, func(r rune) bool { return r == '|' || r == ',' }) {
+		i := indiceLetra(letra)
+		if i < 0 || i >= len(alternativas) {
+			return nil, "Alternativa correta inexistente: " + strings.TrimSpace(letra)
+		}
+		alternativas[i].Correta = true
+	}
+	return alternativas, ""
+}
+
+// letrasCorretas é o inverso de alternativasDasLetras.
+func letrasCorretas(alternativas []AlternativaQuestao) string {
+	var letras []string
+	for i, a := range alternativas {
+		if a.Correta {
+			letras = append(letras, letrasAlternativas[i])
+		}
+	}
+	return strings.Join(letras, separadorLista)
+}
+
+func (e EntradaQuestao) questao() (QuestaoData, string) {
+	antigas := []string{e.AlternativaA, e.AlternativaB, e.AlternativaC, e.AlternativaD, e.AlternativaE}
+	if !slices.ContainsFunc(antigas, func(t string) bool { return t != "" }) && e.Correta == "" {
+		return e.QuestaoData, ""
+	}
+	if len(e.Alternativas) > 0 {
+		return e.QuestaoData, "Use alternativas ou alternativa_a a alternativa_e, não os dois"
+	}
+
+	q := e.QuestaoData
+	if q.Tipo == "" {
+		q.Tipo = TipoUnica
+	}
+	var msg string
+	q.Alternativas, msg = alternativasDasLetras(antigas, e.Correta)
+	return q, msg
+}
+
+// validarQuestao normaliza os campos e devolve a mensagem de erro, ou "".
+// Campos que não se aplicam ao tipo da questão são descartados.
+func validarQuestao(q *Questao) string {
+	q.Pergunta = strings.TrimSpace(q.Pergunta)
+	if q.Pergunta == "" {
+		return "A pergunta não pode ser vazia"
+	}
+
+	if q.Tipo == "" {
+		q.Tipo = TipoUnica
+	}
+	if !slices.Contains(tiposQuestao, q.Tipo) {
+		return "Tipo de questão inválido: " + string(q.Tipo)
+	}
+
+	switch q.Tipo {
+	case TipoUnica, TipoVerdadeiroFalso, TipoMultipla:
+		q.RespostaNumerica, q.Tolerancia, q.RespostasAceitas = nil, nil, nil
+
+		if q.Tipo == TipoVerdadeiroFalso && len(q.Alternativas) != 2 {
+			return "A questão de verdadeiro ou falso deve ter duas alternativas"
+		}
+		if len(q.Alternativas) < minimoAlternativas || len(q.Alternativas) > len(letrasAlternativas) {
+			return fmt.Sprintf("A questão deve ter de %v a %v alternativas", minimoAlternativas, len(letrasAlternativas))
+		}
+
+		corretas := 0
+		for i := range q.Alternativas {
+			q.Alternativas[i].Texto = strings.TrimSpace(q.Alternativas[i].Texto)
+			if q.Alternativas[i].Texto == "" {
+				return "A alternativa " + letrasAlternativas[i] + " não pode ser vazia"
+			}
+			if q.Alternativas[i].Correta {
+				corretas++
+			}
+		}
+		if q.Tipo != TipoMultipla && corretas != 1 {
+			return "A questão deve ter exatamente uma alternativa correta"
+		}
+		if corretas == 0 {
+			return "A questão deve ter pelo menos uma alternativa correta"
+		}
+
+	case TipoNumerica:
+		q.Alternativas, q.RespostasAceitas = nil, nil
+
+		if q.RespostaNumerica == nil || math.IsNaN(*q.RespostaNumerica) || math.IsInf(*q.RespostaNumerica, 0) {
+			return "A questão numérica precisa de resposta_numerica"
+		}
+		if q.Tolerancia == nil {
+			q.Tolerancia = new(float64)
+		}
+		if math.IsNaN(*q.Tolerancia) || math.IsInf(*q.Tolerancia, 0) || *q.Tolerancia < 0 {
+			return "A tolerância deve ser um número maior ou igual a zero"
+		}
+
+	case TipoTexto:
+		q.Alternativas, q.RespostaNumerica, q.Tolerancia = nil, nil, nil
+
+		aceitas := []string{}
+		for _, a := range q.RespostasAceitas {
+			// | separa as respostas no CSV de importação e exportação
+			if strings.Contains(a, separadorLista) {
+				return fmt.Sprintf("Resposta aceita inválida (não pode ter %q): %v", separadorLista, a)
+			}
+			if a = strings.TrimSpace(a); a != "" && !slices.Contains(aceitas, a) {
+				aceitas = append(aceitas, a)
+			}
+		}
+		if len(aceitas) == 0 {
+			return "A questão de resposta curta precisa de respostas_aceitas"
+		}
+		if len(aceitas) > len(letrasAlternativas) {
+			return fmt.Sprintf("A questão pode ter no máximo %v respostas aceitas", len(letrasAlternativas))
+		}
+		q.RespostasAceitas = aceitas
+	}
+
+	q.Explicacao = textoOpcional(q.Explicacao)
+	var msg string
+	if q.Referencias, msg = validarReferencias(q.Referencias); msg != "" {
+		return msg
+	}
+
+	q.Fonte = textoOpcional(q.Fonte)
+	q.Atribuicao = textoOpcional(q.Atribuicao)
+	if q.Fonte != nil && utf8.RuneCountInString(*q.Fonte) > 255 {
+		return "A fonte deve ter no máximo 255 caracteres"
+	}
+	if q.Dificuldade = textoOpcional(q.Dificuldade); q.Dificuldade != nil {
+		*q.Dificuldade = strings.ToLower(*q.Dificuldade)
+		if !slices.Contains(dificuldades, *q.Dificuldade) {
+			return "A dificuldade deve ser facil, media ou dificil"
+		}
+	}
+
+	q.Topicos, q.Tags, msg = normalizarClassificacao(q.Topicos, q.Tags)
+	return msg
+}
+
+// textoOpcional tira os espaços e troca texto vazio por nil.
+func textoOpcional(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	t := strings.TrimSpace(*s)
+	if t == "" {
+		return nil
+	}
+	return &t
+}
+
+func mesmaQuestao(a, b QuestaoData) bool {
+	iguais := func(x, y *string) bool {
+		return (x == nil && y == nil) || (x != nil && y != nil && *x == *y)
+	}
+	mesmoNumero := func(x, y *float64) bool {
+		return (x == nil && y == nil) || (x != nil && y != nil && *x == *y)
+	}
+	return a.Pergunta == b.Pergunta && a.Tipo == b.Tipo && slices.Equal(a.Alternativas, b.Alternativas) &&
+		mesmoNumero(a.RespostaNumerica, b.RespostaNumerica) && mesmoNumero(a.Tolerancia, b.Tolerancia) &&
+		slices.Equal(a.RespostasAceitas, b.RespostasAceitas) && iguais(a.Explicacao, b.Explicacao) && mesmasReferencias(a.Referencias, b.Referencias) &&
+		iguais(a.Fonte, b.Fonte) && iguais(a.Atribuicao, b.Atribuicao) &&
+		iguais(a.Dificuldade, b.Dificuldade) && slices.Equal(a.Topicos, b.Topicos) && slices.Equal(a.Tags, b.Tags)
+}
+
+// autorDaRequisicao identifica quem mexeu no banco de questões, para o log.
+func autorDaRequisicao(u Usuario) string {
+	if u.ChaveAPI != "" {
+		return "chave de API " + u.ChaveAPI
+	}
+	return "usuário " + u.UUID
+}
+
+func buscarQuestaoAdmin(conn *sql.DB, id int) (Questao, error) {
+	q := Questao{ID: id}
+	err := conn.QueryRow(`
+    SELECT pergunta, tipo, resposta_numerica, tolerancia, explicacao, fonte, atribuicao, dificuldade
+    FROM questoes
+    WHERE id = ? AND removida_em IS NULL`, id).Scan(&q.Pergunta, &q.Tipo, &q.RespostaNumerica, &q.Tolerancia, &q.Explicacao, &q.Fonte, &q.Atribuicao, &q.Dificuldade)
+	if err != nil {
+		return q, err
+	}
+
+	alternativas, err := carregarAlternativas(conn, []int{id})
+	if err != nil {
+		return q, err
+	}
+	q.usarAlternativas(alternativas[id])
+
+	referencias, err := carregarReferencias(conn, []int{id})
+	if err != nil {
+		return q, err
+	}
+	q.Referencias = append([]Referencia{}, referencias[id]...)
+
+	topicos, tags, err := carregarClassificacao(conn, []int{id})
+	q.Topicos, q.Tags = append([]string{}, topicos[id]...), append([]string{}, tags[id]...)
+	return q, err
+}
+
+// salvarQuestao cria (ID 0) ou atualiza a questão com a classificação, numa
+// transação.
+func salvarQuestao(conn *sql.DB, q *Questao) error {
+	tx, err := conn.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := gravarQuestao(tx, q); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func gravarQuestao(tx execer, q *Questao) error {
+	if q.ID == 0 {
+		res, err := tx.Exec("INSERT INTO questoes (pergunta, tipo, resposta_numerica, tolerancia, explicacao, fonte, atribuicao, dificuldade) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+			q.Pergunta, q.Tipo, q.RespostaNumerica, q.Tolerancia, q.Explicacao, q.Fonte, q.Atribuicao, q.Dificuldade)
+		if err != nil {
+			return err
+		}
+		id, err := res.LastInsertId()
+		if err != nil {
+			return err
+		}
+		q.ID = int(id)
+	} else {
+		_, err := tx.Exec(`
+    UPDATE questoes
+    SET pergunta = ?, tipo = ?, resposta_numerica = ?, tolerancia = ?, explicacao = ?, fonte = ?, atribuicao = ?, dificuldade = ?
+    WHERE id = ?`,
+			q.Pergunta, q.Tipo, q.RespostaNumerica, q.Tolerancia, q.Explicacao, q.Fonte, q.Atribuicao, q.Dificuldade, q.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := salvarAlternativas(tx, q.ID, q.QuestaoData); err != nil {
+		return err
+	}
+	if err := salvarReferencias(tx, q.ID, q.Referencias); err != nil {
+		return err
+	}
+	return salvarClassificacao(tx, q.ID, q.Topicos, q.Tags)
+}
+
+func criarQuestao(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodPost {
+		w.WriteHeader(406)
+		return
+	}
+	usuario := usuarioDoContexto(r)
+	var entrada EntradaQuestao
+
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	err := d.Decode(&entrada)
+
+	if err != nil || d.More() {
+		enviarErrorJson(w, "Estrutura do JSON incorreta.", 400)
+		return
+	}
+
+	dados, msg := entrada.questao()
+	if msg != "" {
+		enviarErrorJson(w, msg, 400)
+		return
+	}
+
+	q := Questao{QuestaoData: dados}
+	if msg := validarQuestao(&q); msg != "" {
+		enviarErrorJson(w, msg, 400)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	var topico errTopicoDesconhecido
+	if err := salvarQuestao(conn, &q); errors.As(err, &topico) {
+		enviarErrorJson(w, "Tópico inexistente: "+string(topico), 400)
+		return
+	} else if err != nil {
+		logger.Println("[e] Erro ao criar questão:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	logger.Printf("[i] questão %v criada por %v\n", q.ID, autorDaRequisicao(usuario))
+	enviarRespostaJson(w, q, 201)
+}
+
+// aplicar copia para q os campos enviados no PATCH. alternativa_a a
+// alternativa_e e correta mexem nas alternativas atuais, como no formato
+// antigo.
+func (p PatchQuestaoData) aplicar(q *QuestaoData) string {
+	if p.Pergunta != nil {
+		q.Pergunta = *p.Pergunta
+	}
+	if p.Tipo != nil {
+		q.Tipo = *p.Tipo
+	}
+	if p.Alternativas != nil {
+		q.Alternativas = *p.Alternativas
+	}
+	if p.RespostaNumerica != nil {
+		q.RespostaNumerica = p.RespostaNumerica
+	}
+	if p.Tolerancia != nil {
+		q.Tolerancia = p.Tolerancia
+	}
+	if p.RespostasAceitas != nil {
+		q.RespostasAceitas = *p.RespostasAceitas
+	}
+	if p.Explicacao != nil {
+		q.Explicacao = p.Explicacao
+	}
+	if p.Referencias != nil {
+		q.Referencias = *p.Referencias
+	}
+
+	q.Alternativas = slices.Clone(q.Alternativas)
+	for i, texto := range []*string{p.AlternativaA, p.AlternativaB, p.AlternativaC, p.AlternativaD, p.AlternativaE} {
+		switch {
+		case texto == nil:
+		case i < len(q.Alternativas):
+			q.Alternativas[i].Texto = *texto
+		case i == len(q.Alternativas):
+			q.Alternativas = append(q.Alternativas, AlternativaQuestao{Texto: *texto})
+		default:
+			return "A alternativa " + letrasAlternativas[len(q.Alternativas)] + " não pode ser vazia"
+		}
+	}
+	if p.Correta != nil {
+		for i := range q.Alternativas {
+			q.Alternativas[i].Correta = false
+		}
+		alternativas, msg := alternativasDasLetras(textosAlternativas(q.Alternativas), *p.Correta)
+		if msg != "" {
+			return msg
+		}
+		q.Alternativas = alternativas
+	}
+
+	if p.Fonte != nil {
+		q.Fonte = p.Fonte
+	}
+	if p.Atribuicao != nil {
+		q.Atribuicao = p.Atribuicao
+	}
+	if p.Dificuldade != nil {
+		q.Dificuldade = p.Dificuldade
+	}
+	if p.Topicos != nil {
+		q.Topicos = *p.Topicos
+	}
+	if p.Tags != nil {
+		q.Tags = *p.Tags
+	}
+	return ""
+}
+
+// questaoAdmin atende /quest/question/{id}: PUT substitui a questão inteira,
+// PATCH só os campos enviados e DELETE remove.
+func questaoAdmin(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodPut && r.Method != http.MethodPatch && r.Method != http.MethodDelete {
+		w.WriteHeader(406)
+		return
+	}
+	usuario := usuarioDoContexto(r)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		enviarErrorJson(w, "ID da pergunta inválido", 400)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	if r.Method == http.MethodDelete {
+		res, err := conn.Exec("UPDATE questoes SET removida_em = NOW() WHERE id = ? AND removida_em IS NULL", id)
+		if err != nil {
+			logger.Printf("[e] Erro ao remover questão %v: %v\n", id, err)
+			enviarErrorJson(w, "Algo deu errado", 500)
+			return
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			enviarErrorJson(w, "Questão não encontrada", 404)
+			return
+		}
+
+		logger.Printf("[i] questão %v removida por %v\n", id, autorDaRequisicao(usuario))
+		enviarRespostaJson(w, "ok", 200)
+		return
+	}
+
+	q, err := buscarQuestaoAdmin(conn, id)
+	if err == sql.ErrNoRows {
+		enviarErrorJson(w, "Questão não encontrada", 404)
+		return
+	} else if err != nil {
+		logger.Printf("[e] Erro ao buscar questão %v: %v\n", id, err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+
+	if r.Method == http.MethodPut {
+		var entrada EntradaQuestao
+		if err := d.Decode(&entrada); err != nil || d.More() {
+			enviarErrorJson(w, "Estrutura do JSON incorreta.", 400)
+			return
+		}
+		dados, msg := entrada.questao()
+		if msg != "" {
+			enviarErrorJson(w, msg, 400)
+			return
+		}
+		q.QuestaoData = dados
+	} else {
+		var dados PatchQuestaoData
+		if err := d.Decode(&dados); err != nil || d.More() {
+			enviarErrorJson(w, "Estrutura do JSON incorreta.", 400)
+			return
+		}
+		if msg := dados.aplicar(&q.QuestaoData); msg != "" {
+			enviarErrorJson(w, msg, 400)
+			return
+		}
+	}
+
+	if msg := validarQuestao(&q); msg != "" {
+		enviarErrorJson(w, msg, 400)
+		return
+	}
+
+	var topico errTopicoDesconhecido
+	if err := salvarQuestao(conn, &q); errors.As(err, &topico) {
+		enviarErrorJson(w, "Tópico inexistente: "+string(topico), 400)
+		return
+	} else if err != nil {
+		logger.Printf("[e] Erro ao atualizar questão %v: %v\n", id, err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	logger.Printf("[i] questão %v alterada por %v\n", id, autorDaRequisicao(usuario))
+	enviarRespostaJson(w, q, 200)
+}
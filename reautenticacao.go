@@ -0,0 +1,112 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Ações sensíveis (excluir a conta, ver o CPF, desativar o 2FA, trocar a
+// senha ou o email) pedem a senha de novo. Contas criadas pelo login institucional não
+// têm senha; elas confirmam a identidade com um login novo no provedor
+// (GET /user/reauth/oidc/start), que devolve um reauth_token de uso único.
+// Senhas erradas contam nas mesmas falhas do /login/auth, para uma sessão
+// roubada não servir para testar senhas à vontade.
+
+const duracaoReautenticacao = 5 * time.Minute
+
+var (
+	errSenhaIncorreta         = errors.New("senha incorreta")
+	errContaSemSenha          = errors.New("conta sem senha")
+	errReautenticacaoInvalida = errors.New("reautenticação inválida ou expirada")
+)
+
+// errMuitasTentativas diz quanto falta para a conta poder tentar de novo.
+type errMuitasTentativas time.Duration
+
+func (e errMuitasTentativas) Error() string {
+	return fmt.Sprintf("muitas tentativas, espere %v", time.Duration(e))
+}
+
+func chaveReautenticacao(hash string) string {
+	return fmt.Sprintf("reautenticacao:%s", hash)
+}
+
+type ReautenticacaoResponse struct {
+	Token   string `json:"reauth_token"`
+	Expires int64  `json:"expiration"`
+}
+
+func criarReautenticacao(userID string) (ReautenticacaoResponse, error) {
+	segredo, err := gerarSegredo()
+	if err != nil {
+		return ReautenticacaoResponse{}, err
+	}
+
+	exp := time.Now().Add(duracaoReautenticacao)
+	chave := chaveReautenticacao(hashSegredo(segredo))
+	pipe := rdb.TxPipeline()
+	pipe.Set(ctx, chave, userID, duracaoReautenticacao)
+	indexarToken(pipe, userID, chave, duracaoReautenticacao)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return ReautenticacaoResponse{}, err
+	}
+	return ReautenticacaoResponse{Token: segredo, Expires: exp.Unix()}, nil
+}
+
+// confirmarIdentidade aceita a senha ou um reauth_token do próprio usuário.
+// senhaSalva vem vazia para contas sem senha.
+func confirmarIdentidade(userID, email, ip, senhaSalva, senha, reautenticacao string) error {
+	if reautenticacao != "" {
+		// o token só vale uma vez
+		dono, err := rdb.GetDel(ctx, chaveReautenticacao(hashSegredo(reautenticacao))).Result()
+		if err == redis.Nil || (err == nil && dono != userID) {
+			return errReautenticacaoInvalida
+		}
+		return err
+	}
+
+	if senhaSalva == "" {
+		return errContaSemSenha
+	}
+
+	espera, err := tempoBloqueioLogin(email, ip)
+	if err != nil {
+		return err
+	}
+	if espera > 0 {
+		return errMuitasTentativas(espera)
+	}
+
+	if bcrypt.CompareHashAndPassword([]byte(senhaSalva), []byte(senha)) != nil {
+		registrarFalhaLogin(email, ip, userID)
+		return errSenhaIncorreta
+	}
+	limparFalhasLogin(email)
+	return nil
+}
+
+// enviarErroIdentidade responde o erro de confirmarIdentidade. msgSenha é a
+// mensagem para a senha errada.
+func enviarErroIdentidade(w http.ResponseWriter, err error, msgSenha string) {
+	if espera, ok := err.(errMuitasTentativas); ok {
+		enviarBloqueado(w, time.Duration(espera))
+		return
+	}
+
+	switch err {
+	case errSenhaIncorreta:
+		enviarErroCodigo(w, codigoSenhaIncorreta, msgSenha, http.StatusForbidden)
+	case errContaSemSenha:
+		enviarErroCodigo(w, codigoContaSemSenha, "A conta não tem senha: confirme pelo login institucional (GET /user/reauth/oidc/start) ou crie uma senha em /login/forgot", http.StatusForbidden)
+	case errReautenticacaoInvalida:
+		enviarErroCodigo(w, codigoReautenticacaoInvalida, "Confirmação expirada ou já usada, faça de novo", http.StatusForbidden)
+	default:
+		logger.Println("[e] Erro ao confirmar identidade:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+	}
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Recuperação de senha: /login/forgot gera um token de uso único que vai por
+// e-mail, /login/reset troca a senha com ele.
+
+const duracaoReset = 30 * time.Minute
+
+func chaveReset(hash string) string {
+	return fmt.Sprintf("reset:%s", hash)
+}
+
+func chaveResetUsuario(userID string) string {
+	return fmt.Sprintf("user:%s:reset", userID)
+}
+
+type EsqueciSenhaData struct {
+	Email string `json:"email"`
+}
+
+type ResetSenhaData struct {
+	Token string `json:"token"`
+	Senha string `json:"senha"`
+}
+
+func esqueciSenha(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodPost {
+		w.WriteHeader(406)
+		return
+	}
+	var dados EsqueciSenhaData
+
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	err := d.Decode(&dados)
+
+	if err != nil || d.More() {
+		enviarErrorJson(w, "Estrutura do JSON incorreta.", 400)
+		return
+	}
+
+	if _, err := mail.ParseAddress(dados.Email); err != nil {
+		enviarErrorJson(w, "Email inválido", 400)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	// a resposta é sempre a mesma, exista o e-mail ou não
+	var uuidUsuario string
+	err = conn.QueryRow("SELECT id FROM users WHERE email = ?", dados.Email).Scan(&uuidUsuario)
+	if err == nil {
+		if err := enviarResetSenha(uuidUsuario, dados.Email); err != nil {
+			logger.Printf("[e] Erro ao criar token de recuperação para %v: %v\n", uuidUsuario, err)
+		}
+	}
+
+	enviarRespostaJson(w, "ok", 200)
+}
+
+func enviarResetSenha(userID, email string) error {
+	segredo, err := gerarSegredo()
+	if err != nil {
+		return err
+	}
+	hash := hashSegredo(segredo)
+
+	// só o último link enviado vale
+	anterior, err := rdb.Get(ctx, chaveResetUsuario(userID)).Result()
+	if err != nil && err != redis.Nil {
+		return err
+	}
+
+	pipe := rdb.TxPipeline()
+	if anterior != "" {
+		pipe.Del(ctx, chaveReset(anterior))
+	}
+	pipe.Set(ctx, chaveReset(hash), userID, duracaoReset)
+	pipe.Set(ctx, chaveResetUsuario(userID), hash, duracaoReset)
+	indexarToken(pipe, userID, chaveReset(hash), duracaoReset)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+
+	enviarEmail(Mensagem{
+		Para:    email,
+		Assunto: "Brain Quest - Recuperação de senha",
+		Corpo: fmt.Sprintf("Recebemos um pedido para trocar a sua senha.\n\n"+
+			"Para criar uma senha nova, acesse:\n%s\n\n"+
+			"O link vale por %v minutos e só pode ser usado uma vez.\n"+
+			"Se não foi você, ignore este e-mail.\n",
+			linkFrontend("/reset?token=%s", segredo), duracaoReset.Minutes()),
+	})
+	return nil
+}
+
+func resetarSenha(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodPost {
+		w.WriteHeader(406)
+		return
+	}
+	var dados ResetSenhaData
+
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	err := d.Decode(&dados)
+
+	if err != nil || d.More() || dados.Token == "" {
+		enviarErrorJson(w, "Estrutura do JSON incorreta.", 400)
+		return
+	}
+
+	hash := hashSegredo(dados.Token)
+	uuidUsuario, err := rdb.Get(ctx, chaveReset(hash)).Result()
+	if err == redis.Nil {
+		enviarErrorJson(w, "Token de recuperação inválido ou expirado", 401)
+		return
+	} else if err != nil {
+		logger.Println("[e] Erro ao buscar token de recuperação:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	// a senha é validada antes de gastar o token, para o usuário poder tentar de novo
+	msg, err := validarSenhaUsuario(conn, uuidUsuario, dados.Senha)
+	if err != nil {
+		logger.Println("[e] Erro ao validar senha:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	if msg != "" {
+		enviarErroCodigo(w, codigoSenhaFraca, msg, 400)
+		return
+	}
+
+	if n, err := rdb.Del(ctx, chaveReset(hash)).Result(); err != nil || n == 0 {
+		// outro pedido usou o token nesse meio tempo
+		enviarErrorJson(w, "Token de recuperação inválido ou expirado", 401)
+		return
+	}
+	rdb.Del(ctx, chaveResetUsuario(uuidUsuario))
+
+	hashedPassword, err := gerarHashSenha(dados.Senha)
+	if err != nil {
+		enviarErrorJson(w, "Falha ao criar hash do password", 500)
+		return
+	}
+
+	if _, err := conn.Exec("UPDATE users SET senha = ? WHERE id = ?", hashedPassword, uuidUsuario); err != nil {
+		logger.Printf("[e] Erro ao trocar a senha de %v: %v\n", uuidUsuario, err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	// quem tinha a senha antiga não deve continuar logado
+	if err := encerrarSessoes(uuidUsuario); err != nil {
+		logger.Printf("[w] falha ao revogar sessões de %v: %v\n", uuidUsuario, err)
+	}
+
+	enviarRespostaJson(w, "ok", 200)
+}
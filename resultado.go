@@ -0,0 +1,130 @@
+package main
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Resultado de POST /quest/question/answer/{id}. Na versão 1 da API o
+// acerto vai no status (202 certo, 204 errado, sem corpo) e só o acerto traz
+// a pergunta com a resposta correta. A partir da 2 a resposta é sempre 200 com o resultado
+// completo; clientes novos mandam X-API-Version: 2.
+
+const (
+	versaoAPIStatus = 1
+	versaoAPIAtual  = 2
+
+	xpPorAcerto = 10
+	// bônus por acerto seguido, até maximoBonusSequencia acertos
+	xpBonusSequencia     = 2
+	maximoBonusSequencia = 5
+)
+
+type Placar struct {
+	Respondidas int `json:"answered"`
+	Acertos     int `json:"correct"`
+	Erros       int `json:"wrong"`
+}
+
+type Sequencia struct {
+	Atual    int `json:"current"`
+	Anterior int `json:"previous"`
+}
+
+type XP struct {
+	Ganho int `json:"gained"`
+	Total int `json:"total"`
+}
+
+type ResultadoResposta struct {
+	Versao               int          `json:"version"`
+	Correta              bool         `json:"correct"`
+	AlternativaCorreta   string       `json:"correct_alternative"`
+	AlternativaEscolhida string       `json:"chosen_alternative"`
+	Pergunta             string       `json:"question"`
+	Placar               Placar       `json:"counters"`
+	Sequencia            Sequencia    `json:"streak"`
+	XP                   XP           `json:"xp"`
+	Explicacao           *string      `json:"explanation"`
+	Referencias          []Referencia `json:"references"`
+}
+
+// versaoDaRequisicao lê o header X-API-Version; sem ele vale a 1, que é o
+// que os clientes de antes do header esperam.
+func versaoDaRequisicao(r *http.Request) (int, bool) {
+	v := strings.TrimSpace(r.Header.Get("X-API-Version"))
+	if v == "" {
+		return versaoAPIStatus, true
+	}
+	n, err := strconv.Atoi(v)
+	return n, err == nil && n >= versaoAPIStatus && n <= versaoAPIAtual
+}
+
+// xpDoAcerto é o XP de um acerto depois de sequenciaAnterior acertos seguidos.
+func xpDoAcerto(sequenciaAnterior int) int {
+	return xpPorAcerto + xpBonusSequencia*min(sequenciaAnterior, maximoBonusSequencia)
+}
+
+// atualizarPlacar soma a resposta aos contadores do usuário e devolve o
+// resultado, numa transação para duas respostas ao mesmo tempo não lerem a
+// mesma sequência.
+func atualizarPlacar(conn *sql.DB, userID string, acertou bool) (ResultadoResposta, error) {
+	var res ResultadoResposta
+
+	tx, err := conn.Begin()
+	if err != nil {
+		return res, err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRow("SELECT quest_feitas, alternativas_acertas, alternativas_erradas, sequencia_acertos, xp FROM dados WHERE id = ? FOR UPDATE", userID).
+		Scan(&res.Placar.Respondidas, &res.Placar.Acertos, &res.Placar.Erros, &res.Sequencia.Anterior, &res.XP.Total)
+	if err != nil {
+		return res, err
+	}
+
+	res.Placar.Respondidas++
+	if acertou {
+		res.Placar.Acertos++
+		res.Sequencia.Atual = res.Sequencia.Anterior + 1
+		res.XP.Ganho = xpDoAcerto(res.Sequencia.Anterior)
+	} else {
+		res.Placar.Erros++
+	}
+	res.XP.Total += res.XP.Ganho
+
+	_, err = tx.Exec(`
+    UPDATE dados
+    SET quest_feitas = ?, alternativas_acertas = ?, alternativas_erradas = ?, sequencia_acertos = ?, xp = ?
+    WHERE id = ?`, res.Placar.Respondidas, res.Placar.Acertos, res.Placar.Erros, res.Sequencia.Atual, res.XP.Total, userID)
+	if err != nil {
+		return res, err
+	}
+	return res, tx.Commit()
+}
+
+// respostaEscolhida descreve o que o usuário respondeu, no mesmo formato de
+// respostaCorreta (letras na ordem em que ele viu as alternativas).
+func respostaEscolhida(tipo TipoQuestao, r RespostaQuiz) string {
+	switch tipo {
+	case TipoMultipla:
+		letras := r.Alternativas
+		if len(letras) == 0 && r.Alternativa != "" {
+			letras = []string{r.Alternativa}
+		}
+		alternativas := make([]AlternativaQuestao, len(letrasAlternativas))
+		for _, letra := range letras {
+			if i := indiceLetra(letra); i >= 0 {
+				alternativas[i].Correta = true
+			}
+		}
+		return strings.ReplaceAll(letrasCorretas(alternativas), separadorLista, ",")
+	case TipoNumerica:
+		return numeroOuVazio(r.Valor)
+	case TipoTexto:
+		return strings.TrimSpace(r.Texto)
+	}
+	return strings.ToUpper(strings.TrimSpace(r.Alternativa))
+}
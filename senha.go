@@ -0,0 +1,240 @@
+package main
+
+import (
+	"bufio"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Política de senha e troca de senha pelo próprio usuário.
+//
+// A política é configurável pelo .env: tamanho mínimo (senha_tamanho_minimo),
+// custo do bcrypt (bcrypt_custo) e um arquivo extra de senhas proibidas, uma
+// por linha (senhas_bloqueadas). Senhas com custo abaixo do configurado são
+// refeitas no próximo login.
+
+const (
+	tamanhoMinimoSenhaPadrao = 8
+	custoSenhaPadrao         = 12
+	// o bcrypt ignora o que passar de 72 bytes
+	tamanhoMaximoSenha = 72
+)
+
+var (
+	tamanhoMinimoSenha = tamanhoMinimoSenhaPadrao
+	custoSenha         = custoSenhaPadrao
+	senhasBloqueadas   = map[string]bool{}
+)
+
+// senhas comuns que sempre são recusadas, além das do arquivo
+var senhasComuns = []string{
+	"12345678", "123456789", "1234567890", "12341234", "87654321", "11111111", "00000000",
+	"password", "password1", "qwerty123", "qwertyui", "asdfghjk", "iloveyou", "abc12345",
+	"senha123", "senha1234", "minhasenha", "mudar123", "123mudar", "admin123", "brasil123",
+	"flamengo", "palmeiras", "corinthians", "princesa", "brainquest",
+}
+
+func iniciarPoliticaSenha() error {
+	if v := os.Getenv("senha_tamanho_minimo"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > tamanhoMaximoSenha {
+			return fmt.Errorf("senha_tamanho_minimo inválido: %v", v)
+		}
+		tamanhoMinimoSenha = n
+	}
+
+	if v := os.Getenv("bcrypt_custo"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < bcrypt.MinCost || n > bcrypt.MaxCost {
+			return fmt.Errorf("bcrypt_custo inválido: %v (entre %v e %v)", v, bcrypt.MinCost, bcrypt.MaxCost)
+		}
+		custoSenha = n
+	}
+
+	for _, s := range senhasComuns {
+		senhasBloqueadas[s] = true
+	}
+	if arquivo := os.Getenv("senhas_bloqueadas"); arquivo != "" {
+		f, err := os.Open(arquivo)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			if s := strings.TrimSpace(scanner.Text()); s != "" {
+				senhasBloqueadas[strings.ToLower(s)] = true
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validarSenha devolve a mensagem de erro para o usuário, ou "" se a senha
+// for aceita. email e cpf são do dono da senha e podem ser vazios.
+func validarSenha(senha, email, cpf string) string {
+	if utf8.RuneCountInString(senha) < tamanhoMinimoSenha {
+		return fmt.Sprintf("A senha deve ter pelo menos %v caracteres", tamanhoMinimoSenha)
+	}
+	if len(senha) > tamanhoMaximoSenha {
+		return fmt.Sprintf("A senha deve ter no máximo %v bytes", tamanhoMaximoSenha)
+	}
+
+	minuscula := strings.ToLower(senha)
+	if senhasBloqueadas[minuscula] {
+		return "Essa senha é muito comum, escolha outra"
+	}
+
+	if email != "" {
+		usuario, _, _ := strings.Cut(strings.ToLower(email), "@")
+		if strings.Contains(minuscula, strings.ToLower(email)) || (len(usuario) >= 4 && strings.Contains(minuscula, usuario)) {
+			return "A senha não pode conter o seu email"
+		}
+	}
+
+	if cpf = normalizarCPF(cpf); cpf != "" && strings.Contains(normalizarCPF(senha), cpf) {
+		return "A senha não pode conter o seu CPF"
+	}
+
+	return ""
+}
+
+// validarSenhaUsuario aplica a política com o email e o CPF do usuário.
+func validarSenhaUsuario(conn *sql.DB, userID, senha string) (string, error) {
+	var email, cpfCifrado string
+	if err := conn.QueryRow("SELECT email, COALESCE(cpf_cifrado, '') FROM users WHERE id = ?", userID).Scan(&email, &cpfCifrado); err != nil {
+		return "", err
+	}
+
+	cpf, err := decifrar(cpfCifrado)
+	if err != nil {
+		return "", err
+	}
+
+	return validarSenha(senha, email, cpf), nil
+}
+
+func gerarHashSenha(senha string) ([]byte, error) {
+	return bcrypt.GenerateFromPassword([]byte(senha), custoSenha)
+}
+
+// atualizarCustoSenha refaz o hash se ele foi gerado com um custo menor que
+// o configurado. Só pode ser chamada com a senha já conferida.
+func atualizarCustoSenha(conn *sql.DB, userID string, hashSalvo []byte, senha string) {
+	custo, err := bcrypt.Cost(hashSalvo)
+	if err != nil || custo >= custoSenha {
+		return
+	}
+
+	novoHash, err := gerarHashSenha(senha)
+	if err != nil {
+		logger.Printf("[w] falha ao refazer hash da senha de %v: %v\n", userID, err)
+		return
+	}
+	if _, err := conn.Exec("UPDATE users SET senha = ? WHERE id = ?", novoHash, userID); err != nil {
+		logger.Printf("[w] falha ao salvar hash novo da senha de %v: %v\n", userID, err)
+	}
+}
+
+type TrocaSenhaData struct {
+	SenhaAtual     string `json:"senha_atual"`
+	Reautenticacao string `json:"reauth_token"`
+	SenhaNova      string `json:"senha_nova"`
+}
+
+// trocarSenha troca a senha e encerra todas as sessões do usuário. Quem fez
+// a troca recebe um par de tokens novo para continuar logado.
+func trocarSenha(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodPost {
+		w.WriteHeader(406)
+		return
+	}
+	usuario := usuarioDoContexto(r)
+	var dados TrocaSenhaData
+
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	err := d.Decode(&dados)
+
+	if err != nil || d.More() || (dados.SenhaAtual == "" && dados.Reautenticacao == "") {
+		enviarErrorJson(w, "Estrutura do JSON incorreta.", 400)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	var senhaSalva, email string
+	err = conn.QueryRow("SELECT COALESCE(senha, ''), email FROM users WHERE id = ?", usuario.UUID).Scan(&senhaSalva, &email)
+	if err == sql.ErrNoRows {
+		enviarErrorJson(w, "O usuário não existe mais", 401)
+		return
+	} else if err != nil {
+		logger.Println("[e] Erro ao buscar senha:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	if err := confirmarIdentidade(usuario.UUID, email, ipCliente(r), senhaSalva, dados.SenhaAtual, dados.Reautenticacao); err != nil {
+		enviarErroIdentidade(w, err, "Senha atual incorreta")
+		return
+	}
+
+	msg, err := validarSenhaUsuario(conn, usuario.UUID, dados.SenhaNova)
+	if err != nil {
+		logger.Println("[e] Erro ao validar senha:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	if msg != "" {
+		enviarErroCodigo(w, codigoSenhaFraca, msg, 400)
+		return
+	}
+	if dados.SenhaNova == dados.SenhaAtual {
+		enviarErroCodigo(w, codigoSenhaFraca, "A senha nova deve ser diferente da atual", 400)
+		return
+	}
+
+	hashedPassword, err := gerarHashSenha(dados.SenhaNova)
+	if err != nil {
+		enviarErrorJson(w, "Falha ao criar hash do password", 500)
+		return
+	}
+
+	if _, err := conn.Exec("UPDATE users SET senha = ? WHERE id = ?", hashedPassword, usuario.UUID); err != nil {
+		logger.Printf("[e] Erro ao trocar a senha de %v: %v\n", usuario.UUID, err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	if err := encerrarSessoes(usuario.UUID); err != nil {
+		logger.Printf("[e] falha ao encerrar sessões de %v: %v\n", usuario.UUID, err)
+		enviarErrorJson(w, "Senha trocada, mas não foi possível encerrar as outras sessões", 500)
+		return
+	}
+
+	tokens, err := iniciarSessao(usuario.UUID, usuario.Papel, r)
+	if err != nil {
+		logger.Println("[e] Erro ao iniciar sessão:", err)
+		enviarErrorJson(w, "Erro ao criar sessão", 500)
+		return
+	}
+
+	enviarRespostaJson(w, tokens, 200)
+}
@@ -0,0 +1,368 @@
+package main
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	paseto "aidanwoods.dev/go-paseto"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// O token de acesso vive pouco; quem mantém a sessão é o refresh token, que
+// fica salvo no Redis e é trocado a cada uso. Todos os refresh tokens gerados
+// a partir de um mesmo login formam uma "família", que pode ser revogada de
+// uma vez (logout ou reuso de um refresh token antigo).
+const (
+	duracaoAcesso  = 15 * time.Minute
+	duracaoRefresh = 30 * 24 * time.Hour
+)
+
+var (
+	errRefreshInvalido    = errors.New("refresh token inválido ou expirado")
+	errRefreshReutilizado = errors.New("refresh token reutilizado")
+)
+
+func chaveFamilia(familia string) string {
+	return fmt.Sprintf("familia:%s", familia)
+}
+
+func chaveRefresh(hash string) string {
+	return fmt.Sprintf("refresh:%s", hash)
+}
+
+func chaveFamiliasUsuario(userID string) string {
+	return fmt.Sprintf("user:%s:familias", userID)
+}
+
+func chaveEmitidosApos(userID string) string {
+	return fmt.Sprintf("user:%s:emitidos_apos", userID)
+}
+
+// gerarSegredo devolve 32 bytes aleatórios em base64 (url-safe).
+func gerarSegredo() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashSegredo é usado para não guardar segredos em texto puro no Redis.
+func hashSegredo(segredo string) string {
+	h := sha256.Sum256([]byte(segredo))
+	return hex.EncodeToString(h[:])
+}
+
+// iniciarSessao cria uma nova família de tokens para o usuário e devolve o
+// primeiro par acesso/refresh. O dispositivo e o IP da requisição ficam
+// guardados na família para a listagem de sessões.
+func iniciarSessao(userID string, papel Papel, r *http.Request) (LoginResponse, error) {
+	familia := uuid.New().String()
+	agora := time.Now().Unix()
+
+	pipe := rdb.TxPipeline()
+	pipe.HSet(ctx, chaveFamilia(familia), "uid", userID, "criada", agora, "ultimo_uso", agora, "revogada", 0,
+		"user_agent", r.UserAgent(), "ip", ipCliente(r))
+	pipe.Expire(ctx, chaveFamilia(familia), duracaoRefresh)
+	pipe.SAdd(ctx, chaveFamiliasUsuario(userID), familia)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return LoginResponse{}, err
+	}
+
+	return emitirTokens(userID, familia, papel)
+}
+
+func emitirTokens(userID, familia string, papel Papel) (LoginResponse, error) {
+	refresh, refreshExp, err := emitirRefresh(userID, familia)
+	if err != nil {
+		return LoginResponse{}, err
+	}
+
+	acesso, acessoExp, err := emitirAcesso(userID, familia, papel)
+	if err != nil {
+		return LoginResponse{}, err
+	}
+
+	return LoginResponse{
+		Token:          acesso,
+		Expires:        acessoExp.Unix(),
+		RefreshToken:   refresh,
+		RefreshExpires: refreshExp.Unix(),
+	}, nil
+}
+
+func emitirAcesso(userID, familia string, papel Papel) (string, time.Time, error) {
+	agora := time.Now()
+
+	token := paseto.NewToken()
+	token.SetIssuedAt(agora)
+	token.SetNotBefore(agora)
+	token.SetExpiration(agora.Add(duracaoAcesso))
+
+	token.SetString("id", userID)
+	token.SetString("fam", familia)
+	token.SetString("role", string(papel))
+
+	assinado, err := assinarToken(token)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	exp, _ := token.GetExpiration()
+	return assinado, exp, nil
+}
+
+func emitirRefresh(userID, familia string) (string, time.Time, error) {
+	segredo, err := gerarSegredo()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	exp := time.Now().Add(duracaoRefresh)
+	chave := chaveRefresh(hashSegredo(segredo))
+
+	pipe := rdb.TxPipeline()
+	pipe.HSet(ctx, chave, "uid", userID, "familia", familia, "usado", 0)
+	pipe.ExpireAt(ctx, chave, exp)
+	// a família vive enquanto houver refresh token válido nela
+	pipe.ExpireAt(ctx, chaveFamilia(familia), exp)
+	pipe.ExpireAt(ctx, chaveFamiliasUsuario(userID), exp)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return "", time.Time{}, err
+	}
+
+	return segredo, exp, nil
+}
+
+// renovarSessao troca um refresh token por um par novo.
+func renovarSessao(refresh string) (LoginResponse, error) {
+	userID, familia, err := usarRefresh(refresh)
+	if err != nil {
+		return LoginResponse{}, err
+	}
+
+	// o papel é relido do banco para a troca de papel valer na renovação
+	conn, err := OpenConn()
+	if err != nil {
+		return LoginResponse{}, err
+	}
+	defer conn.Close()
+
+	papel, err := buscarPapel(conn, userID)
+	if err == sql.ErrNoRows {
+		return LoginResponse{}, errRefreshInvalido
+	} else if err != nil {
+		return LoginResponse{}, err
+	}
+
+	return emitirTokens(userID, familia, papel)
+}
+
+// usarRefresh gasta o refresh token e devolve o usuário e a família dele. Um
+// refresh token só pode ser usado uma vez: se aparecer de novo, alguém o
+// copiou, e a família inteira é revogada.
+func usarRefresh(refresh string) (string, string, error) {
+	chave := chaveRefresh(hashSegredo(refresh))
+
+	dados, err := rdb.HGetAll(ctx, chave).Result()
+	if err != nil {
+		return "", "", err
+	}
+	if len(dados) == 0 {
+		return "", "", errRefreshInvalido
+	}
+
+	usos, err := rdb.HIncrBy(ctx, chave, "usado", 1).Result()
+	if err != nil {
+		return "", "", err
+	}
+	if usos > 1 {
+		if err := revogarFamilia(dados["familia"]); err != nil {
+			logger.Printf("[e] falha ao revogar família %v: %v\n", dados["familia"], err)
+		}
+		return "", "", errRefreshReutilizado
+	}
+
+	ativa, err := familiaAtiva(dados["familia"])
+	if err != nil {
+		return "", "", err
+	}
+	if !ativa {
+		return "", "", errRefreshInvalido
+	}
+	rdb.HSet(ctx, chaveFamilia(dados["familia"]), "ultimo_uso", time.Now().Unix())
+	return dados["uid"], dados["familia"], nil
+}
+
+// revogarFamilia invalida todos os tokens (acesso e refresh) de um login.
+// O registro da família é mantido até expirar para que reusos continuem
+// sendo detectados.
+func revogarFamilia(familia string) error {
+	uid, err := rdb.HGet(ctx, chaveFamilia(familia), "uid").Result()
+	if err == redis.Nil {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	pipe := rdb.TxPipeline()
+	pipe.HSet(ctx, chaveFamilia(familia), "revogada", 1)
+	pipe.SRem(ctx, chaveFamiliasUsuario(uid), familia)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// revogarTodasFamilias encerra todas as sessões do usuário.
+func revogarTodasFamilias(userID string) error {
+	familias, err := rdb.SMembers(ctx, chaveFamiliasUsuario(userID)).Result()
+	if err != nil {
+		return err
+	}
+
+	for _, familia := range familias {
+		if err := revogarFamilia(familia); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encerrarSessoes revoga todas as famílias do usuário e marca que tokens de
+// acesso emitidos antes de agora não valem mais. A marca só precisa durar o
+// tempo de vida de um token de acesso.
+func encerrarSessoes(userID string) error {
+	agora := time.Now().Truncate(time.Second)
+	if err := rdb.Set(ctx, chaveEmitidosApos(userID), agora.Unix(), duracaoAcesso).Err(); err != nil {
+		return err
+	}
+	return revogarTodasFamilias(userID)
+}
+
+// sessaoValida confere, para um token de acesso, se a família não foi
+// revogada e se ele foi emitido depois da última vez que as sessões do
+// usuário foram encerradas.
+func sessaoValida(userID, familia string, emitido time.Time) (bool, error) {
+	pipe := rdb.Pipeline()
+	revogada := pipe.HGet(ctx, chaveFamilia(familia), "revogada")
+	emitidosApos := pipe.Get(ctx, chaveEmitidosApos(userID))
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return false, err
+	}
+
+	if revogada.Val() != "0" {
+		return false, nil
+	}
+	if apos, err := emitidosApos.Int64(); err == nil && emitido.Unix() < apos {
+		return false, nil
+	}
+	return true, nil
+}
+
+func familiaAtiva(familia string) (bool, error) {
+	revogada, err := rdb.HGet(ctx, chaveFamilia(familia), "revogada").Result()
+	if err == redis.Nil {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return revogada == "0", nil
+}
+
+type RefreshData struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+func renovarToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodPost {
+		w.WriteHeader(406)
+		return
+	}
+	var dados RefreshData
+
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	err := d.Decode(&dados)
+
+	if err != nil || d.More() || dados.RefreshToken == "" {
+		enviarErrorJson(w, "Estrutura do JSON incorreta.", 400)
+		return
+	}
+
+	tokens, err := renovarSessao(dados.RefreshToken)
+	if errors.Is(err, errRefreshReutilizado) {
+		logger.Println("[w] Refresh token reutilizado, família revogada.")
+		registrarEvento(EventoSeguranca{IP: ipCliente(r), Tipo: eventoRefreshReutilizado,
+			Detalhe: "refresh token já usado foi enviado de novo, sessão revogada"})
+		enviarErrorJson(w, "Sessão encerrada, faça login novamente", 401)
+		return
+	} else if errors.Is(err, errRefreshInvalido) {
+		enviarErrorJson(w, "Refresh token inválido ou expirado", 401)
+		return
+	} else if err != nil {
+		logger.Println("[e] Erro ao renovar sessão:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	enviarRespostaJson(w, tokens, 200)
+}
+
+// logout revoga a família do refresh token enviado no corpo ou, se não houver
+// corpo, a família do token de acesso do header Authorization.
+func logout(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodPost {
+		w.WriteHeader(406)
+		return
+	}
+	var dados RefreshData
+
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&dados); err != nil && !errors.Is(err, io.EOF) {
+		enviarErrorJson(w, "Estrutura do JSON incorreta.", 400)
+		return
+	}
+
+	var familia string
+	if dados.RefreshToken != "" {
+		f, err := rdb.HGet(ctx, chaveRefresh(hashSegredo(dados.RefreshToken)), "familia").Result()
+		if err == redis.Nil {
+			enviarErrorJson(w, "Refresh token inválido ou expirado", 401)
+			return
+		} else if err != nil {
+			logger.Println("[e] Erro ao buscar refresh token:", err)
+			enviarErrorJson(w, "Algo deu errado", 500)
+			return
+		}
+		familia = f
+	} else if strings.TrimSpace(r.Header.Get("Authorization")) != "" {
+		uid := getUserUUID(r)
+		if uid.Status != 200 {
+			enviarErrorJson(w, uid.Message, uid.Status)
+			return
+		}
+		familia = uid.Familia
+	} else {
+		enviarErrorJson(w, "Token faltando ou incorreta", 400)
+		return
+	}
+
+	if err := revogarFamilia(familia); err != nil {
+		logger.Println("[e] Erro ao revogar família:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	enviarRespostaJson(w, "ok", 200)
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+// redisDeTeste troca o rdb por um Redis em memória durante o teste.
+func redisDeTeste(t *testing.T) *miniredis.Miniredis {
+	t.Helper()
+	loggerDeTeste(t)
+	m := miniredis.RunT(t)
+
+	anterior := rdb
+	rdb = redis.NewClient(&redis.Options{Addr: m.Addr()})
+	t.Cleanup(func() {
+		rdb.Close()
+		rdb = anterior
+	})
+	return m
+}
+
+// sessaoDeTeste faz o login de uid e devolve os tokens e a família.
+func sessaoDeTeste(t *testing.T, uid string) (LoginResponse, string) {
+	t.Helper()
+	tokens, err := iniciarSessao(uid, PapelAluno, httptest.NewRequest("POST", "/login/auth", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	familias, err := rdb.SMembers(ctx, chaveFamiliasUsuario(uid)).Result()
+	if err != nil || len(familias) != 1 {
+		t.Fatalf("famílias de %v: %v %v", uid, familias, err)
+	}
+	return tokens, familias[0]
+}
+
+func TestRenovarRefresh(t *testing.T) {
+	casos := []struct {
+		nome string
+		// usos do refresh do login, em ordem, e o erro esperado em cada um
+		usos     []error
+		revogada bool
+	}{
+		{"primeiro uso", []error{nil}, false},
+		{"reuso revoga a família", []error{nil, errRefreshReutilizado}, true},
+		{"terceiro uso continua recusado", []error{nil, errRefreshReutilizado, errRefreshReutilizado}, true},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			redisDeTeste(t)
+			chaveiroDeTeste(t)
+			tokens, familia := sessaoDeTeste(t, "usuario-1")
+
+			for i, esperado := range c.usos {
+				uid, fam, err := usarRefresh(tokens.RefreshToken)
+				if !errors.Is(err, esperado) {
+					t.Fatalf("uso %v: erro %v, esperava %v", i+1, err, esperado)
+				}
+				if err == nil && (uid != "usuario-1" || fam != familia) {
+					t.Errorf("uso %v: devolveu %v/%v", i+1, uid, fam)
+				}
+			}
+
+			ativa, err := familiaAtiva(familia)
+			if err != nil || ativa == c.revogada {
+				t.Errorf("familiaAtiva = %v (%v), esperava %v", ativa, err, !c.revogada)
+			}
+		})
+	}
+}
+
+// O par novo da renovação funciona, mas se o refresh antigo reaparecer o
+// par novo também cai.
+func TestRotacaoRefresh(t *testing.T) {
+	redisDeTeste(t)
+	chaveiroDeTeste(t)
+	login, familia := sessaoDeTeste(t, "usuario-1")
+
+	uid, fam, err := usarRefresh(login.RefreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	renovado, err := emitirTokens(uid, fam, PapelAluno)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if renovado.RefreshToken == login.RefreshToken {
+		t.Fatal("a renovação devolveu o mesmo refresh token")
+	}
+
+	// o refresh antigo copiado por outra pessoa
+	if _, _, err := usarRefresh(login.RefreshToken); !errors.Is(err, errRefreshReutilizado) {
+		t.Fatalf("reuso: erro %v, esperava errRefreshReutilizado", err)
+	}
+	if _, _, err := usarRefresh(renovado.RefreshToken); !errors.Is(err, errRefreshInvalido) {
+		t.Errorf("refresh novo depois do reuso: erro %v, esperava errRefreshInvalido", err)
+	}
+
+	valida, err := sessaoValida(uid, familia, time.Now())
+	if err != nil || valida {
+		t.Errorf("token de acesso da família revogada ainda vale (%v)", err)
+	}
+	if n, _ := rdb.SCard(ctx, chaveFamiliasUsuario(uid)).Result(); n != 0 {
+		t.Errorf("a família revogada continua na lista de sessões (%v)", n)
+	}
+}
+
+func TestRefreshDesconhecido(t *testing.T) {
+	redisDeTeste(t)
+	if _, _, err := usarRefresh("nao-existe"); !errors.Is(err, errRefreshInvalido) {
+		t.Errorf("erro %v, esperava errRefreshInvalido", err)
+	}
+}
+
+func TestRefreshExpirado(t *testing.T) {
+	m := redisDeTeste(t)
+	chaveiroDeTeste(t)
+	tokens, _ := sessaoDeTeste(t, "usuario-1")
+
+	m.FastForward(duracaoRefresh + time.Second)
+	if _, _, err := usarRefresh(tokens.RefreshToken); !errors.Is(err, errRefreshInvalido) {
+		t.Errorf("erro %v, esperava errRefreshInvalido", err)
+	}
+}
+
+// encerrarSessoes derruba as outras sessões do usuário e os tokens de acesso
+// já emitidos, sem mexer nas sessões de outro usuário.
+func TestEncerrarSessoes(t *testing.T) {
+	redisDeTeste(t)
+	chaveiroDeTeste(t)
+	celular, famCelular := sessaoDeTeste(t, "usuario-1")
+	outro, famOutro := sessaoDeTeste(t, "usuario-2")
+	emitido := time.Now().Add(-time.Minute)
+
+	if err := encerrarSessoes("usuario-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := usarRefresh(celular.RefreshToken); !errors.Is(err, errRefreshInvalido) {
+		t.Errorf("refresh do usuário encerrado: erro %v, esperava errRefreshInvalido", err)
+	}
+	if valida, _ := sessaoValida("usuario-1", famCelular, emitido); valida {
+		t.Error("token de acesso emitido antes do encerramento ainda vale")
+	}
+
+	if _, _, err := usarRefresh(outro.RefreshToken); err != nil {
+		t.Errorf("sessão de outro usuário caiu: %v", err)
+	}
+	if valida, _ := sessaoValida("usuario-2", famOutro, emitido); !valida {
+		t.Error("token de acesso de outro usuário deixou de valer")
+	}
+}
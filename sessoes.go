@@ -0,0 +1,91 @@
+package main
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+// Listagem das sessões abertas do usuário e logout por dispositivo. Cada
+// sessão é uma família de tokens (ver sessao.go).
+
+type Sessao struct {
+	ID        string `json:"id"`
+	UserAgent string `json:"user_agent"`
+	IP        string `json:"ip"`
+	Criada    int64  `json:"created_at"`
+	UltimoUso int64  `json:"last_used_at"`
+	Atual     bool   `json:"current"`
+}
+
+func listarSessoes(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodGet {
+		w.WriteHeader(406)
+		return
+	}
+	usuario := usuarioDoContexto(r)
+
+	familias, err := rdb.SMembers(ctx, chaveFamiliasUsuario(usuario.UUID)).Result()
+	if err != nil {
+		logger.Println("[e] Erro ao listar sessões:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	sessoes := []Sessao{}
+	for _, familia := range familias {
+		dados, err := rdb.HGetAll(ctx, chaveFamilia(familia)).Result()
+		if err != nil {
+			logger.Println("[e] Erro ao buscar sessão:", err)
+			enviarErrorJson(w, "Algo deu errado", 500)
+			return
+		}
+
+		// a família expirou ou foi revogada: só tira do conjunto
+		if len(dados) == 0 || dados["revogada"] != "0" {
+			rdb.SRem(ctx, chaveFamiliasUsuario(usuario.UUID), familia)
+			continue
+		}
+
+		criada, _ := strconv.ParseInt(dados["criada"], 10, 64)
+		ultimoUso, _ := strconv.ParseInt(dados["ultimo_uso"], 10, 64)
+		sessoes = append(sessoes, Sessao{
+			ID:        familia,
+			UserAgent: dados["user_agent"],
+			IP:        dados["ip"],
+			Criada:    criada,
+			UltimoUso: ultimoUso,
+			Atual:     familia == usuario.Familia,
+		})
+	}
+
+	sort.Slice(sessoes, func(i, j int) bool {
+		return sessoes[i].UltimoUso > sessoes[j].UltimoUso
+	})
+
+	enviarRespostaJson(w, sessoes, 200)
+}
+
+func encerrarSessao(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodDelete {
+		w.WriteHeader(406)
+		return
+	}
+	usuario := usuarioDoContexto(r)
+	familia := r.PathValue("id")
+
+	dono, err := rdb.HGet(ctx, chaveFamilia(familia), "uid").Result()
+	if err != nil || dono != usuario.UUID {
+		// não diferencia sessão de outro usuário de sessão inexistente
+		enviarErrorJson(w, "Sessão não encontrada", 404)
+		return
+	}
+
+	if err := revogarFamilia(familia); err != nil {
+		logger.Println("[e] Erro ao revogar sessão:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	enviarRespostaJson(w, "ok", 200)
+}
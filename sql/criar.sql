@@ -1,3 +1,13 @@
+DROP TABLE IF EXISTS referencias;
+DROP TABLE IF EXISTS alternativas;
+DROP TABLE IF EXISTS questoes_tags;
+DROP TABLE IF EXISTS questoes_topicos;
+DROP TABLE IF EXISTS tags;
+DROP TABLE IF EXISTS topicos;
+DROP TABLE IF EXISTS identidades;
+DROP TABLE IF EXISTS chaves_api;
+DROP TABLE IF EXISTS eventos_seguranca;
+DROP TABLE IF EXISTS codigos_recuperacao;
 DROP TABLE IF EXISTS dados;
 DROP TABLE IF EXISTS questoes;
 DROP TABLE IF EXISTS users;
@@ -5,21 +15,80 @@ DROP TABLE IF EXISTS users;
 CREATE TABLE users (
     id CHAR(36) PRIMARY KEY NOT NULL UNIQUE,
     email VARCHAR(255) NOT NULL UNIQUE,
-    senha TEXT NOT NULL,
-    cpf VARCHAR(20) NOT NULL UNIQUE,
+    senha TEXT,
+    cpf_cifrado TEXT,
+    cpf_indice CHAR(64) UNIQUE,
     nome VARCHAR(255) NOT NULL,
-    telefone VARCHAR(20)
+    telefone VARCHAR(20),
+    verificado BOOLEAN NOT NULL DEFAULT FALSE,
+    papel ENUM('admin', 'teacher', 'student') NOT NULL DEFAULT 'student',
+    exclusao_agendada DATETIME,
+    totp_segredo TEXT,
+    totp_ativo BOOLEAN NOT NULL DEFAULT FALSE
 );
 
 CREATE TABLE questoes (
     id INT AUTO_INCREMENT PRIMARY KEY,
     pergunta TEXT NOT NULL,
-    alternativa_a TEXT NOT NULL,
-    alternativa_b TEXT NOT NULL,
-    alternativa_c TEXT NOT NULL,
-    alternativa_d TEXT NOT NULL,
-    alternativa_e TEXT NOT NULL,
-    correta CHAR(1) NOT NULL
+    tipo ENUM('unica', 'verdadeiro_falso', 'multipla', 'numerica', 'texto') NOT NULL DEFAULT 'unica',
+    resposta_numerica DOUBLE,
+    tolerancia DOUBLE,
+    explicacao TEXT,
+    fonte VARCHAR(255),
+    atribuicao TEXT,
+    dificuldade ENUM('facil', 'media', 'dificil'),
+    removida_em DATETIME,
+    INDEX idx_questoes_dificuldade (dificuldade),
+    FULLTEXT INDEX ft_questoes_pergunta (pergunta)
+);
+
+CREATE TABLE alternativas (
+    questao_id INT NOT NULL,
+    posicao TINYINT NOT NULL,
+    texto TEXT NOT NULL,
+    correta BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (questao_id, posicao),
+    FULLTEXT INDEX ft_alternativas_texto (texto),
+    CONSTRAINT fk_alternativas_questao FOREIGN KEY (questao_id) REFERENCES questoes(id)
+);
+
+CREATE TABLE referencias (
+    questao_id INT NOT NULL,
+    posicao TINYINT NOT NULL,
+    trecho TEXT NOT NULL,
+    fonte VARCHAR(255),
+    url VARCHAR(2048),
+    PRIMARY KEY (questao_id, posicao),
+    CONSTRAINT fk_referencias_questao FOREIGN KEY (questao_id) REFERENCES questoes(id)
+);
+
+CREATE TABLE topicos (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    slug VARCHAR(64) NOT NULL UNIQUE,
+    nome VARCHAR(255) NOT NULL
+);
+
+CREATE TABLE tags (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    nome VARCHAR(64) NOT NULL UNIQUE
+);
+
+CREATE TABLE questoes_topicos (
+    questao_id INT NOT NULL,
+    topico_id INT NOT NULL,
+    PRIMARY KEY (questao_id, topico_id),
+    INDEX idx_questoes_topicos_topico (topico_id),
+    CONSTRAINT fk_questoes_topicos_questao FOREIGN KEY (questao_id) REFERENCES questoes(id),
+    CONSTRAINT fk_questoes_topicos_topico FOREIGN KEY (topico_id) REFERENCES topicos(id)
+);
+
+CREATE TABLE questoes_tags (
+    questao_id INT NOT NULL,
+    tag_id INT NOT NULL,
+    PRIMARY KEY (questao_id, tag_id),
+    INDEX idx_questoes_tags_tag (tag_id),
+    CONSTRAINT fk_questoes_tags_questao FOREIGN KEY (questao_id) REFERENCES questoes(id),
+    CONSTRAINT fk_questoes_tags_tag FOREIGN KEY (tag_id) REFERENCES tags(id)
 );
 
 CREATE TABLE dados (
@@ -27,11 +96,56 @@ CREATE TABLE dados (
     quest_feitas INT NOT NULL DEFAULT 0,
     alternativas_acertas INT NOT NULL DEFAULT 0,
     alternativas_erradas INT NOT NULL DEFAULT 0,
+    sequencia_acertos INT NOT NULL DEFAULT 0,
+    xp INT NOT NULL DEFAULT 0,
     dias_logados INT NOT NULL DEFAULT 0,
     ultimo_login DATE NOT NULL DEFAULT (CURRENT_DATE),
     CONSTRAINT fk_dados_users FOREIGN KEY (id) REFERENCES users(id)
 );
 
+CREATE TABLE codigos_recuperacao (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id CHAR(36) NOT NULL,
+    hash CHAR(64) NOT NULL,
+    usado_em DATETIME,
+    INDEX idx_recuperacao_user (user_id),
+    CONSTRAINT fk_recuperacao_users FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE TABLE eventos_seguranca (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id CHAR(36),
+    email VARCHAR(255),
+    ip VARCHAR(45),
+    tipo VARCHAR(32) NOT NULL,
+    detalhe TEXT,
+    criado_em DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_eventos_user (user_id),
+    INDEX idx_eventos_email (email)
+);
+
+CREATE TABLE chaves_api (
+    id CHAR(12) PRIMARY KEY,
+    nome VARCHAR(100) NOT NULL,
+    hash CHAR(64) NOT NULL,
+    escopos VARCHAR(255) NOT NULL,
+    criada_por CHAR(36),
+    criada_em DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    revogada_em DATETIME,
+    ultimo_uso DATETIME,
+    CONSTRAINT fk_chaves_api_users FOREIGN KEY (criada_por) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE TABLE identidades (
+    emissor VARCHAR(255) NOT NULL,
+    sub VARCHAR(255) NOT NULL,
+    user_id CHAR(36) NOT NULL,
+    criada_em DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (emissor, sub),
+    INDEX idx_identidades_user (user_id),
+    CONSTRAINT fk_identidades_users FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
 DELIMITER $$
 
 CREATE TRIGGER after_user_insert
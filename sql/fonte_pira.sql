@@ -0,0 +1,13 @@
+-- Marca como vindas do dataset Pirá as questões carregadas pelo populate.sql
+-- num banco criado antes da migração 011 (num banco novo o próprio populate
+-- já preenche a fonte).
+--
+-- Não faz parte das migrações porque o banco não guarda quais questões vieram
+-- do populate. Rode só se o populate.sql foi carregado num banco vazio, o que
+-- dá a elas os ids 1 a 1798, e confira antes que nenhuma questão criada pela
+-- API ou importada ficou nessa faixa.
+UPDATE questoes
+SET fonte = 'Pirá',
+    atribuicao = 'Pirá: A Bilingual Portuguese-English Dataset for Question-Answering about the Ocean (Paschoal et al., 2021), CC BY 4.0'
+WHERE id BETWEEN 1 AND 1798
+  AND fonte IS NULL;
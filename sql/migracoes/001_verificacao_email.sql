@@ -0,0 +1,5 @@
+-- Estado de verificação do email.
+-- Contas que já existiam antes da verificação continuam liberadas.
+ALTER TABLE users ADD COLUMN verificado BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE users SET verificado = TRUE;
@@ -0,0 +1,12 @@
+-- Eventos de segurança (falhas de login, bloqueios, reuso de refresh token).
+CREATE TABLE eventos_seguranca (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id CHAR(36),
+    email VARCHAR(255),
+    ip VARCHAR(45),
+    tipo VARCHAR(32) NOT NULL,
+    detalhe TEXT,
+    criado_em DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_eventos_user (user_id),
+    INDEX idx_eventos_email (email)
+);
@@ -0,0 +1,3 @@
+-- Papel do usuário (admin, teacher, student).
+-- O primeiro admin é definido com: ./backend definir-papel <email> admin
+ALTER TABLE users ADD COLUMN papel ENUM('admin', 'teacher', 'student') NOT NULL DEFAULT 'student';
@@ -0,0 +1,2 @@
+-- Data em que a conta será apagada (LGPD). NULL = nenhuma exclusão pedida.
+ALTER TABLE users ADD COLUMN exclusao_agendada DATETIME;
@@ -0,0 +1,6 @@
+-- CPF cifrado (AES-GCM) + índice cego (HMAC) no lugar do CPF em texto puro.
+-- Depois desta migração, rode `./backend cifrar-cpf` e então a 006.
+ALTER TABLE users
+    ADD COLUMN cpf_cifrado TEXT,
+    ADD COLUMN cpf_indice CHAR(64) UNIQUE,
+    MODIFY cpf VARCHAR(20) NULL;
@@ -0,0 +1,5 @@
+-- Rodar só depois de `./backend cifrar-cpf`.
+ALTER TABLE users
+    DROP COLUMN cpf,
+    MODIFY cpf_cifrado TEXT NOT NULL,
+    MODIFY cpf_indice CHAR(64) NOT NULL;
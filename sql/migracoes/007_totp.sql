@@ -0,0 +1,13 @@
+-- Autenticação em dois fatores (TOTP) e códigos de recuperação.
+ALTER TABLE users
+    ADD COLUMN totp_segredo TEXT,
+    ADD COLUMN totp_ativo BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE codigos_recuperacao (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id CHAR(36) NOT NULL,
+    hash CHAR(64) NOT NULL,
+    usado_em DATETIME,
+    INDEX idx_recuperacao_user (user_id),
+    CONSTRAINT fk_recuperacao_users FOREIGN KEY (user_id) REFERENCES users(id)
+);
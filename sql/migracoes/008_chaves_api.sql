@@ -0,0 +1,12 @@
+-- Chaves de API para integrações. Só o hash do segredo é guardado.
+CREATE TABLE chaves_api (
+    id CHAR(12) PRIMARY KEY,
+    nome VARCHAR(100) NOT NULL,
+    hash CHAR(64) NOT NULL,
+    escopos VARCHAR(255) NOT NULL,
+    criada_por CHAR(36),
+    criada_em DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    revogada_em DATETIME,
+    ultimo_uso DATETIME,
+    CONSTRAINT fk_chaves_api_users FOREIGN KEY (criada_por) REFERENCES users(id) ON DELETE SET NULL
+);
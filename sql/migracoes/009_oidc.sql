@@ -0,0 +1,16 @@
+-- Login institucional (OIDC). Contas criadas por ele não têm senha e só
+-- recebem o CPF quando o usuário completa o perfil.
+ALTER TABLE users
+    MODIFY senha TEXT,
+    MODIFY cpf_cifrado TEXT,
+    MODIFY cpf_indice CHAR(64);
+
+CREATE TABLE identidades (
+    emissor VARCHAR(255) NOT NULL,
+    sub VARCHAR(255) NOT NULL,
+    user_id CHAR(36) NOT NULL,
+    criada_em DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (emissor, sub),
+    INDEX idx_identidades_user (user_id),
+    CONSTRAINT fk_identidades_users FOREIGN KEY (user_id) REFERENCES users(id)
+);
@@ -0,0 +1,3 @@
+-- Questões removidas pela API continuam no banco para o histórico.
+ALTER TABLE questoes
+    ADD COLUMN removida_em DATETIME;
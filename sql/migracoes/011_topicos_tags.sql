@@ -0,0 +1,36 @@
+-- Tópicos, tags e origem das questões.
+ALTER TABLE questoes
+    ADD COLUMN fonte VARCHAR(255) AFTER correta,
+    ADD COLUMN atribuicao TEXT AFTER fonte;
+
+CREATE TABLE topicos (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    slug VARCHAR(64) NOT NULL UNIQUE,
+    nome VARCHAR(255) NOT NULL
+);
+
+CREATE TABLE tags (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    nome VARCHAR(64) NOT NULL UNIQUE
+);
+
+CREATE TABLE questoes_topicos (
+    questao_id INT NOT NULL,
+    topico_id INT NOT NULL,
+    PRIMARY KEY (questao_id, topico_id),
+    INDEX idx_questoes_topicos_topico (topico_id),
+    CONSTRAINT fk_questoes_topicos_questao FOREIGN KEY (questao_id) REFERENCES questoes(id),
+    CONSTRAINT fk_questoes_topicos_topico FOREIGN KEY (topico_id) REFERENCES topicos(id)
+);
+
+CREATE TABLE questoes_tags (
+    questao_id INT NOT NULL,
+    tag_id INT NOT NULL,
+    PRIMARY KEY (questao_id, tag_id),
+    INDEX idx_questoes_tags_tag (tag_id),
+    CONSTRAINT fk_questoes_tags_questao FOREIGN KEY (questao_id) REFERENCES questoes(id),
+    CONSTRAINT fk_questoes_tags_tag FOREIGN KEY (tag_id) REFERENCES tags(id)
+);
+
+-- A fonte das questões do populate.sql que já estavam no banco é preenchida
+-- à parte, por sql/fonte_pira.sql.
@@ -0,0 +1,7 @@
+-- Listagem de questões: filtro por dificuldade e busca textual.
+ALTER TABLE questoes
+    ADD COLUMN dificuldade ENUM('facil', 'media', 'dificil') AFTER atribuicao,
+    ADD INDEX idx_questoes_dificuldade (dificuldade);
+
+ALTER TABLE questoes
+    ADD FULLTEXT INDEX ft_questoes_texto (pergunta, alternativa_a, alternativa_b, alternativa_c, alternativa_d, alternativa_e);
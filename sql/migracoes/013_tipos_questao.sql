@@ -0,0 +1,35 @@
+-- Tipos de questão: as alternativas saem das colunas alternativa_a a
+-- alternativa_e para uma tabela, com 2 a 10 por questão e mais de uma correta
+-- nas de múltipla escolha. Nas de resposta curta a tabela guarda as respostas
+-- aceitas; nas numéricas a resposta fica em resposta_numerica.
+ALTER TABLE questoes
+    ADD COLUMN tipo ENUM('unica', 'verdadeiro_falso', 'multipla', 'numerica', 'texto') NOT NULL DEFAULT 'unica' AFTER pergunta,
+    ADD COLUMN resposta_numerica DOUBLE AFTER tipo,
+    ADD COLUMN tolerancia DOUBLE AFTER resposta_numerica;
+
+CREATE TABLE alternativas (
+    questao_id INT NOT NULL,
+    posicao TINYINT NOT NULL,
+    texto TEXT NOT NULL,
+    correta BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (questao_id, posicao),
+    FULLTEXT INDEX ft_alternativas_texto (texto),
+    CONSTRAINT fk_alternativas_questao FOREIGN KEY (questao_id) REFERENCES questoes(id)
+);
+
+INSERT INTO alternativas (questao_id, posicao, texto, correta)
+SELECT id, 1, alternativa_a, correta = 'A' FROM questoes
+UNION ALL SELECT id, 2, alternativa_b, correta = 'B' FROM questoes
+UNION ALL SELECT id, 3, alternativa_c, correta = 'C' FROM questoes
+UNION ALL SELECT id, 4, alternativa_d, correta = 'D' FROM questoes
+UNION ALL SELECT id, 5, alternativa_e, correta = 'E' FROM questoes;
+
+ALTER TABLE questoes
+    DROP INDEX ft_questoes_texto,
+    DROP COLUMN alternativa_a,
+    DROP COLUMN alternativa_b,
+    DROP COLUMN alternativa_c,
+    DROP COLUMN alternativa_d,
+    DROP COLUMN alternativa_e,
+    DROP COLUMN correta,
+    ADD FULLTEXT INDEX ft_questoes_pergunta (pergunta);
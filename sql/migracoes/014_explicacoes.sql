@@ -0,0 +1,14 @@
+-- Explicação e trechos de referência das questões, mostrados depois da
+-- resposta.
+ALTER TABLE questoes
+    ADD COLUMN explicacao TEXT AFTER tolerancia;
+
+CREATE TABLE referencias (
+    questao_id INT NOT NULL,
+    posicao TINYINT NOT NULL,
+    trecho TEXT NOT NULL,
+    fonte VARCHAR(255),
+    url VARCHAR(2048),
+    PRIMARY KEY (questao_id, posicao),
+    CONSTRAINT fk_referencias_questao FOREIGN KEY (questao_id) REFERENCES questoes(id)
+);
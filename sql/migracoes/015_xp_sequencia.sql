@@ -0,0 +1,4 @@
+-- Sequência de acertos e XP, devolvidos no resultado de cada resposta.
+ALTER TABLE dados
+    ADD COLUMN sequencia_acertos INT NOT NULL DEFAULT 0 AFTER alternativas_erradas,
+    ADD COLUMN xp INT NOT NULL DEFAULT 0 AFTER sequencia_acertos;
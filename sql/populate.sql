@@ -1,5 +1,23 @@
+-- As questões são carregadas no formato antigo (cinco alternativas e a letra
+-- correta) numa tabela temporária e convertidas no fim do arquivo.
+--
+-- Ficam sem explicacao e referencias: este arquivo não tem o resumo do artigo
+-- nem a resposta longa de cada questão do Pirá, que é de onde elas viriam.
+-- Quem quiser as explicações importa o dataset com o formato pira
+-- (importar-questoes --pira) em vez de rodar este arquivo.
+CREATE TEMPORARY TABLE carga_questoes (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    pergunta TEXT NOT NULL,
+    alternativa_a TEXT NOT NULL,
+    alternativa_b TEXT NOT NULL,
+    alternativa_c TEXT NOT NULL,
+    alternativa_d TEXT NOT NULL,
+    alternativa_e TEXT NOT NULL,
+    correta CHAR(1) NOT NULL
+);
+
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20,7 +38,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -41,7 +59,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -62,7 +80,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -83,7 +101,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -104,7 +122,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -125,7 +143,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -146,7 +164,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -167,7 +185,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -188,7 +206,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -209,7 +227,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -230,7 +248,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -251,7 +269,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -272,7 +290,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -293,7 +311,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -314,7 +332,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -335,7 +353,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -356,7 +374,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -377,7 +395,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -398,7 +416,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -419,7 +437,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -440,7 +458,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -461,7 +479,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -482,7 +500,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -503,7 +521,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -524,7 +542,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -545,7 +563,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -566,7 +584,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -587,7 +605,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -608,7 +626,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -629,7 +647,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -650,7 +668,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -671,7 +689,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -692,7 +710,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -713,7 +731,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -734,7 +752,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -755,7 +773,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -776,7 +794,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -797,7 +815,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -818,7 +836,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -839,7 +857,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -860,7 +878,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -881,7 +899,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -902,7 +920,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -923,7 +941,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -944,7 +962,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -965,7 +983,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -986,7 +1004,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1007,7 +1025,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1028,7 +1046,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1049,7 +1067,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1070,7 +1088,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1091,7 +1109,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1112,7 +1130,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1133,7 +1151,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1154,7 +1172,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1175,7 +1193,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1196,7 +1214,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1217,7 +1235,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1238,7 +1256,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1259,7 +1277,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1280,7 +1298,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1301,7 +1319,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1322,7 +1340,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1343,7 +1361,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1364,7 +1382,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1385,7 +1403,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1406,7 +1424,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1427,7 +1445,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1448,7 +1466,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1469,7 +1487,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1490,7 +1508,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1511,7 +1529,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1532,7 +1550,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1553,7 +1571,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1574,7 +1592,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1595,7 +1613,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1616,7 +1634,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1637,7 +1655,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1658,7 +1676,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1679,7 +1697,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1700,7 +1718,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1721,7 +1739,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1742,7 +1760,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1763,7 +1781,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1784,7 +1802,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1805,7 +1823,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1826,7 +1844,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1847,7 +1865,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1868,7 +1886,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1889,7 +1907,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1910,7 +1928,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1931,7 +1949,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1952,7 +1970,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1973,7 +1991,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -1994,7 +2012,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2015,7 +2033,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2036,7 +2054,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2057,7 +2075,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2078,7 +2096,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2099,7 +2117,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2120,7 +2138,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2141,7 +2159,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2162,7 +2180,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2183,7 +2201,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2204,7 +2222,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2225,7 +2243,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2246,7 +2264,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2267,7 +2285,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2288,7 +2306,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2309,7 +2327,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2330,7 +2348,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2351,7 +2369,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2372,7 +2390,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2393,7 +2411,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2414,7 +2432,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2435,7 +2453,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2456,7 +2474,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2477,7 +2495,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2498,7 +2516,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2519,7 +2537,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2540,7 +2558,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2561,7 +2579,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2582,7 +2600,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2603,7 +2621,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2624,7 +2642,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2645,7 +2663,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2666,7 +2684,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2687,7 +2705,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2708,7 +2726,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2729,7 +2747,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2750,7 +2768,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2771,7 +2789,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2792,7 +2810,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2813,7 +2831,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2834,7 +2852,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2855,7 +2873,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2876,7 +2894,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2897,7 +2915,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2918,7 +2936,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2939,7 +2957,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2960,7 +2978,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -2981,7 +2999,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3002,7 +3020,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3023,7 +3041,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3044,7 +3062,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3065,7 +3083,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3086,7 +3104,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3107,7 +3125,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3128,7 +3146,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3149,7 +3167,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3170,7 +3188,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3191,7 +3209,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3212,7 +3230,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3233,7 +3251,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3254,7 +3272,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3275,7 +3293,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3296,7 +3314,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3317,7 +3335,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3338,7 +3356,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3359,7 +3377,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3380,7 +3398,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3401,7 +3419,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3422,7 +3440,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3443,7 +3461,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3464,7 +3482,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3485,7 +3503,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3506,7 +3524,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3527,7 +3545,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3548,7 +3566,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3569,7 +3587,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3590,7 +3608,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3611,7 +3629,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3632,7 +3650,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3653,7 +3671,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3674,7 +3692,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3695,7 +3713,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3716,7 +3734,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3737,7 +3755,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3758,7 +3776,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3779,7 +3797,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3800,7 +3818,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3821,7 +3839,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3842,7 +3860,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3863,7 +3881,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3884,7 +3902,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3905,7 +3923,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3926,7 +3944,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3947,7 +3965,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3968,7 +3986,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -3989,7 +4007,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4010,7 +4028,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4031,7 +4049,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4052,7 +4070,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4073,7 +4091,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4094,7 +4112,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4115,7 +4133,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4136,7 +4154,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4157,7 +4175,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4178,7 +4196,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4199,7 +4217,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4220,7 +4238,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4241,7 +4259,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4262,7 +4280,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4283,7 +4301,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4304,7 +4322,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4325,7 +4343,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4346,7 +4364,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4367,7 +4385,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4388,7 +4406,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4409,7 +4427,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4430,7 +4448,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4451,7 +4469,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4472,7 +4490,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4493,7 +4511,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4514,7 +4532,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4535,7 +4553,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4556,7 +4574,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4577,7 +4595,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4598,7 +4616,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4619,7 +4637,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4640,7 +4658,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4661,7 +4679,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4682,7 +4700,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4703,7 +4721,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4724,7 +4742,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4745,7 +4763,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4766,7 +4784,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4787,7 +4805,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4808,7 +4826,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4829,7 +4847,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4850,7 +4868,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4871,7 +4889,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4892,7 +4910,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4913,7 +4931,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4934,7 +4952,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4955,7 +4973,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4976,7 +4994,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -4997,7 +5015,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5018,7 +5036,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5039,7 +5057,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5060,7 +5078,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5081,7 +5099,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5102,7 +5120,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5123,7 +5141,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5144,7 +5162,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5165,7 +5183,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5186,7 +5204,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5207,7 +5225,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5228,7 +5246,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5249,7 +5267,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5270,7 +5288,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5291,7 +5309,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5312,7 +5330,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5333,7 +5351,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5354,7 +5372,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5375,7 +5393,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5396,7 +5414,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5417,7 +5435,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5438,7 +5456,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5459,7 +5477,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5480,7 +5498,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5501,7 +5519,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5522,7 +5540,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5543,7 +5561,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5564,7 +5582,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5585,7 +5603,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5606,7 +5624,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5627,7 +5645,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5648,7 +5666,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5669,7 +5687,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5690,7 +5708,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5711,7 +5729,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5732,7 +5750,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5753,7 +5771,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5774,7 +5792,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5795,7 +5813,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5816,7 +5834,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5837,7 +5855,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5858,7 +5876,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5879,7 +5897,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5900,7 +5918,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5921,7 +5939,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5942,7 +5960,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5963,7 +5981,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -5984,7 +6002,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6005,7 +6023,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6026,7 +6044,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6047,7 +6065,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6068,7 +6086,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6089,7 +6107,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6110,7 +6128,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6131,7 +6149,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6152,7 +6170,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6173,7 +6191,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6194,7 +6212,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6215,7 +6233,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6236,7 +6254,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6257,7 +6275,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6278,7 +6296,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6299,7 +6317,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6320,7 +6338,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6341,7 +6359,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6362,7 +6380,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6383,7 +6401,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6404,7 +6422,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6425,7 +6443,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6446,7 +6464,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6467,7 +6485,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6488,7 +6506,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6509,7 +6527,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6530,7 +6548,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6551,7 +6569,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6572,7 +6590,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6593,7 +6611,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6614,7 +6632,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6635,7 +6653,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6656,7 +6674,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6677,7 +6695,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6698,7 +6716,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6719,7 +6737,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6740,7 +6758,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6761,7 +6779,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6782,7 +6800,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6803,7 +6821,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6824,7 +6842,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6845,7 +6863,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6866,7 +6884,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6887,7 +6905,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6908,7 +6926,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6929,7 +6947,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6950,7 +6968,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6971,7 +6989,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -6992,7 +7010,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7013,7 +7031,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7034,7 +7052,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7055,7 +7073,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7076,7 +7094,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7097,7 +7115,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7118,7 +7136,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7139,7 +7157,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7160,7 +7178,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7181,7 +7199,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7202,7 +7220,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7223,7 +7241,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7244,7 +7262,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7265,7 +7283,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7286,7 +7304,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7307,7 +7325,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7328,7 +7346,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7349,7 +7367,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7370,7 +7388,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7391,7 +7409,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7412,7 +7430,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7433,7 +7451,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7454,7 +7472,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7475,7 +7493,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7496,7 +7514,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7517,7 +7535,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7538,7 +7556,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7559,7 +7577,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7580,7 +7598,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7601,7 +7619,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7622,7 +7640,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7643,7 +7661,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7664,7 +7682,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7685,7 +7703,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7706,7 +7724,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7727,7 +7745,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7748,7 +7766,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7769,7 +7787,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7790,7 +7808,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7811,7 +7829,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7832,7 +7850,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7853,7 +7871,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7874,7 +7892,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7895,7 +7913,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7916,7 +7934,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7937,7 +7955,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7958,7 +7976,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -7979,7 +7997,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8000,7 +8018,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8021,7 +8039,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8042,7 +8060,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8063,7 +8081,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8084,7 +8102,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8105,7 +8123,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8126,7 +8144,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8147,7 +8165,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8168,7 +8186,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8189,7 +8207,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8210,7 +8228,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8231,7 +8249,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8252,7 +8270,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8273,7 +8291,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8294,7 +8312,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8315,7 +8333,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8336,7 +8354,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8357,7 +8375,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8378,7 +8396,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8399,7 +8417,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8420,7 +8438,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8441,7 +8459,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8462,7 +8480,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8483,7 +8501,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8504,7 +8522,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8525,7 +8543,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8546,7 +8564,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8567,7 +8585,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8588,7 +8606,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8609,7 +8627,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8630,7 +8648,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8651,7 +8669,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8672,7 +8690,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8693,7 +8711,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8714,7 +8732,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8735,7 +8753,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8756,7 +8774,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8777,7 +8795,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8798,7 +8816,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8819,7 +8837,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8840,7 +8858,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8861,7 +8879,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8882,7 +8900,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8903,7 +8921,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8924,7 +8942,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8945,7 +8963,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8966,7 +8984,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -8987,7 +9005,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9008,7 +9026,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9029,7 +9047,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9050,7 +9068,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9071,7 +9089,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9092,7 +9110,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9113,7 +9131,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9134,7 +9152,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9155,7 +9173,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9176,7 +9194,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9197,7 +9215,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9218,7 +9236,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9239,7 +9257,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9260,7 +9278,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9281,7 +9299,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9302,7 +9320,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9323,7 +9341,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9344,7 +9362,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9365,7 +9383,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9386,7 +9404,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9407,7 +9425,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9428,7 +9446,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9449,7 +9467,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9470,7 +9488,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9491,7 +9509,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9512,7 +9530,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9533,7 +9551,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9554,7 +9572,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9575,7 +9593,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9596,7 +9614,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9617,7 +9635,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9638,7 +9656,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9659,7 +9677,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9680,7 +9698,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9701,7 +9719,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9722,7 +9740,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9743,7 +9761,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9764,7 +9782,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9785,7 +9803,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9806,7 +9824,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9827,7 +9845,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9848,7 +9866,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9869,7 +9887,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9890,7 +9908,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9911,7 +9929,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9932,7 +9950,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9953,7 +9971,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9974,7 +9992,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -9995,7 +10013,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10016,7 +10034,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10037,7 +10055,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10058,7 +10076,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10079,7 +10097,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10100,7 +10118,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10121,7 +10139,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10142,7 +10160,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10163,7 +10181,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10184,7 +10202,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10205,7 +10223,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10226,7 +10244,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10247,7 +10265,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10268,7 +10286,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10289,7 +10307,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10310,7 +10328,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10331,7 +10349,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10352,7 +10370,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10373,7 +10391,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10394,7 +10412,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10415,7 +10433,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10436,7 +10454,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10457,7 +10475,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10478,7 +10496,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10499,7 +10517,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10520,7 +10538,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10541,7 +10559,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10562,7 +10580,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10583,7 +10601,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10604,7 +10622,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10625,7 +10643,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10646,7 +10664,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10667,7 +10685,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10688,7 +10706,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10709,7 +10727,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10730,7 +10748,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10751,7 +10769,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10772,7 +10790,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10793,7 +10811,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10814,7 +10832,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10835,7 +10853,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10856,7 +10874,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10877,7 +10895,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10898,7 +10916,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10919,7 +10937,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10940,7 +10958,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10961,7 +10979,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -10982,7 +11000,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11003,7 +11021,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11024,7 +11042,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11045,7 +11063,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11066,7 +11084,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11087,7 +11105,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11108,7 +11126,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11129,7 +11147,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11150,7 +11168,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11171,7 +11189,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11192,7 +11210,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11213,7 +11231,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11234,7 +11252,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11255,7 +11273,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11276,7 +11294,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11297,7 +11315,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11318,7 +11336,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11339,7 +11357,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11360,7 +11378,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11381,7 +11399,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11402,7 +11420,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11423,7 +11441,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11444,7 +11462,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11465,7 +11483,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11486,7 +11504,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11507,7 +11525,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11528,7 +11546,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11549,7 +11567,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11570,7 +11588,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11591,7 +11609,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11612,7 +11630,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11633,7 +11651,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11654,7 +11672,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11675,7 +11693,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11696,7 +11714,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11717,7 +11735,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11738,7 +11756,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11759,7 +11777,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11780,7 +11798,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11801,7 +11819,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11822,7 +11840,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11843,7 +11861,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11864,7 +11882,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11885,7 +11903,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11906,7 +11924,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11927,7 +11945,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11948,7 +11966,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11969,7 +11987,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -11990,7 +12008,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12011,7 +12029,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12032,7 +12050,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12053,7 +12071,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12074,7 +12092,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12095,7 +12113,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12116,7 +12134,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12137,7 +12155,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12158,7 +12176,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12179,7 +12197,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12200,7 +12218,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12221,7 +12239,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12242,7 +12260,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12263,7 +12281,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12284,7 +12302,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12305,7 +12323,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12326,7 +12344,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12347,7 +12365,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12368,7 +12386,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12389,7 +12407,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12410,7 +12428,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12431,7 +12449,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12452,7 +12470,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12473,7 +12491,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12494,7 +12512,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12515,7 +12533,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12536,7 +12554,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12557,7 +12575,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12578,7 +12596,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12599,7 +12617,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12620,7 +12638,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12641,7 +12659,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12662,7 +12680,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12683,7 +12701,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12704,7 +12722,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12725,7 +12743,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12746,7 +12764,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12767,7 +12785,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12788,7 +12806,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12809,7 +12827,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12830,7 +12848,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12851,7 +12869,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12872,7 +12890,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12893,7 +12911,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12914,7 +12932,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12935,7 +12953,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12956,7 +12974,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12977,7 +12995,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -12998,7 +13016,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13019,7 +13037,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13040,7 +13058,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13061,7 +13079,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13082,7 +13100,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13103,7 +13121,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13124,7 +13142,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13145,7 +13163,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13166,7 +13184,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13187,7 +13205,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13208,7 +13226,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13229,7 +13247,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13250,7 +13268,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13271,7 +13289,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13292,7 +13310,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13313,7 +13331,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13334,7 +13352,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13355,7 +13373,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13376,7 +13394,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13397,7 +13415,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13418,7 +13436,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13439,7 +13457,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13460,7 +13478,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13481,7 +13499,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13502,7 +13520,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13523,7 +13541,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13544,7 +13562,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13565,7 +13583,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13586,7 +13604,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13607,7 +13625,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13628,7 +13646,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13649,7 +13667,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13670,7 +13688,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13691,7 +13709,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13712,7 +13730,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13733,7 +13751,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13754,7 +13772,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13775,7 +13793,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13796,7 +13814,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13817,7 +13835,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13838,7 +13856,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13859,7 +13877,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13880,7 +13898,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13901,7 +13919,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13922,7 +13940,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13943,7 +13961,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13964,7 +13982,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -13985,7 +14003,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14006,7 +14024,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14027,7 +14045,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14048,7 +14066,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14069,7 +14087,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14090,7 +14108,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14111,7 +14129,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14132,7 +14150,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14153,7 +14171,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14174,7 +14192,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14195,7 +14213,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14216,7 +14234,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14237,7 +14255,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14258,7 +14276,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14279,7 +14297,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14300,7 +14318,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14321,7 +14339,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14342,7 +14360,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14363,7 +14381,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14384,7 +14402,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14405,7 +14423,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14426,7 +14444,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14447,7 +14465,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14468,7 +14486,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14489,7 +14507,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14510,7 +14528,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14531,7 +14549,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14552,7 +14570,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14573,7 +14591,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14594,7 +14612,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14615,7 +14633,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14636,7 +14654,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14657,7 +14675,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14678,7 +14696,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14699,7 +14717,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14720,7 +14738,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14741,7 +14759,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14762,7 +14780,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14783,7 +14801,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14804,7 +14822,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14825,7 +14843,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14846,7 +14864,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14867,7 +14885,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14888,7 +14906,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14909,7 +14927,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14930,7 +14948,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14951,7 +14969,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14972,7 +14990,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -14993,7 +15011,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15014,7 +15032,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15035,7 +15053,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15056,7 +15074,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15077,7 +15095,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15098,7 +15116,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15119,7 +15137,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15140,7 +15158,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15161,7 +15179,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15182,7 +15200,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15203,7 +15221,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15224,7 +15242,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15245,7 +15263,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15266,7 +15284,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15287,7 +15305,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15308,7 +15326,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15329,7 +15347,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15350,7 +15368,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15371,7 +15389,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15392,7 +15410,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15413,7 +15431,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15434,7 +15452,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15455,7 +15473,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15476,7 +15494,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15497,7 +15515,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15518,7 +15536,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15539,7 +15557,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15560,7 +15578,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15581,7 +15599,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15602,7 +15620,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15623,7 +15641,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15644,7 +15662,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15665,7 +15683,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15686,7 +15704,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15707,7 +15725,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15728,7 +15746,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15749,7 +15767,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15770,7 +15788,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15791,7 +15809,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15812,7 +15830,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15833,7 +15851,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15854,7 +15872,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15875,7 +15893,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15896,7 +15914,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15917,7 +15935,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15938,7 +15956,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15959,7 +15977,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -15980,7 +15998,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16001,7 +16019,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16022,7 +16040,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16043,7 +16061,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16064,7 +16082,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16085,7 +16103,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16106,7 +16124,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16127,7 +16145,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16148,7 +16166,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16169,7 +16187,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16190,7 +16208,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16211,7 +16229,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16232,7 +16250,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16253,7 +16271,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16274,7 +16292,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16295,7 +16313,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16316,7 +16334,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16337,7 +16355,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16358,7 +16376,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16379,7 +16397,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16400,7 +16418,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16421,7 +16439,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16442,7 +16460,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16463,7 +16481,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16484,7 +16502,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16505,7 +16523,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16526,7 +16544,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16547,7 +16565,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16568,7 +16586,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16589,7 +16607,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16610,7 +16628,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16631,7 +16649,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16652,7 +16670,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16673,7 +16691,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16694,7 +16712,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16715,7 +16733,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16736,7 +16754,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16757,7 +16775,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16778,7 +16796,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16799,7 +16817,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16820,7 +16838,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16841,7 +16859,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16862,7 +16880,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16883,7 +16901,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16904,7 +16922,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16925,7 +16943,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16946,7 +16964,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16967,7 +16985,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -16988,7 +17006,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17009,7 +17027,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17030,7 +17048,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17051,7 +17069,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17072,7 +17090,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17093,7 +17111,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17114,7 +17132,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17135,7 +17153,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17156,7 +17174,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17177,7 +17195,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17198,7 +17216,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17219,7 +17237,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17240,7 +17258,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17261,7 +17279,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17282,7 +17300,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17303,7 +17321,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17324,7 +17342,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17345,7 +17363,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17366,7 +17384,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17387,7 +17405,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17408,7 +17426,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17429,7 +17447,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17450,7 +17468,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17471,7 +17489,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17492,7 +17510,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17513,7 +17531,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17534,7 +17552,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17555,7 +17573,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17576,7 +17594,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17597,7 +17615,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17618,7 +17636,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17639,7 +17657,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17660,7 +17678,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17681,7 +17699,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17702,7 +17720,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17723,7 +17741,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17744,7 +17762,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17765,7 +17783,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17786,7 +17804,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17807,7 +17825,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17828,7 +17846,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17849,7 +17867,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17870,7 +17888,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17891,7 +17909,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17912,7 +17930,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17933,7 +17951,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17954,7 +17972,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17975,7 +17993,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -17996,7 +18014,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18017,7 +18035,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18038,7 +18056,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18059,7 +18077,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18080,7 +18098,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18101,7 +18119,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18122,7 +18140,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18143,7 +18161,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18164,7 +18182,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18185,7 +18203,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18206,7 +18224,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18227,7 +18245,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18248,7 +18266,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18269,7 +18287,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18290,7 +18308,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18311,7 +18329,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18332,7 +18350,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18353,7 +18371,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18374,7 +18392,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18395,7 +18413,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18416,7 +18434,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18437,7 +18455,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18458,7 +18476,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18479,7 +18497,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18500,7 +18518,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18521,7 +18539,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18542,7 +18560,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18563,7 +18581,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18584,7 +18602,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18605,7 +18623,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18626,7 +18644,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18647,7 +18665,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18668,7 +18686,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18689,7 +18707,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18710,7 +18728,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18731,7 +18749,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18752,7 +18770,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18773,7 +18791,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18794,7 +18812,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18815,7 +18833,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18836,7 +18854,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18857,7 +18875,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18878,7 +18896,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18899,7 +18917,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18920,7 +18938,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18941,7 +18959,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18962,7 +18980,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -18983,7 +19001,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19004,7 +19022,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19025,7 +19043,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19046,7 +19064,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19067,7 +19085,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19088,7 +19106,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19109,7 +19127,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19130,7 +19148,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19151,7 +19169,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19172,7 +19190,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19193,7 +19211,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19214,7 +19232,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19235,7 +19253,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19256,7 +19274,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19277,7 +19295,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19298,7 +19316,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19319,7 +19337,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19340,7 +19358,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19361,7 +19379,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19382,7 +19400,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19403,7 +19421,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19424,7 +19442,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19445,7 +19463,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19466,7 +19484,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19487,7 +19505,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19508,7 +19526,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19529,7 +19547,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19550,7 +19568,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19571,7 +19589,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19592,7 +19610,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19613,7 +19631,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19634,7 +19652,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19655,7 +19673,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19676,7 +19694,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19697,7 +19715,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19718,7 +19736,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19739,7 +19757,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19760,7 +19778,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19781,7 +19799,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19802,7 +19820,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19823,7 +19841,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19844,7 +19862,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19865,7 +19883,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19886,7 +19904,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19907,7 +19925,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19928,7 +19946,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19949,7 +19967,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19970,7 +19988,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -19991,7 +20009,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20012,7 +20030,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20033,7 +20051,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20054,7 +20072,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20075,7 +20093,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20096,7 +20114,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20117,7 +20135,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20138,7 +20156,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20159,7 +20177,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20180,7 +20198,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20201,7 +20219,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20222,7 +20240,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20243,7 +20261,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20264,7 +20282,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20285,7 +20303,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20306,7 +20324,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20327,7 +20345,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20348,7 +20366,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20369,7 +20387,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20390,7 +20408,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20411,7 +20429,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20432,7 +20450,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20453,7 +20471,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20474,7 +20492,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20495,7 +20513,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20516,7 +20534,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20537,7 +20555,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20558,7 +20576,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20579,7 +20597,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20600,7 +20618,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20621,7 +20639,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20642,7 +20660,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20663,7 +20681,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20684,7 +20702,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20705,7 +20723,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20726,7 +20744,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20747,7 +20765,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20768,7 +20786,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20789,7 +20807,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20810,7 +20828,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20831,7 +20849,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20852,7 +20870,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20873,7 +20891,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20894,7 +20912,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20915,7 +20933,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20936,7 +20954,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20957,7 +20975,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20978,7 +20996,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -20999,7 +21017,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21020,7 +21038,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21041,7 +21059,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21062,7 +21080,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21083,7 +21101,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21104,7 +21122,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21125,7 +21143,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21146,7 +21164,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21167,7 +21185,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21188,7 +21206,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21209,7 +21227,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21230,7 +21248,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21251,7 +21269,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21272,7 +21290,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21293,7 +21311,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21314,7 +21332,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21335,7 +21353,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21356,7 +21374,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21377,7 +21395,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21398,7 +21416,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21419,7 +21437,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21440,7 +21458,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21461,7 +21479,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21482,7 +21500,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21503,7 +21521,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21524,7 +21542,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21545,7 +21563,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21566,7 +21584,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21587,7 +21605,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21608,7 +21626,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21629,7 +21647,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21650,7 +21668,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21671,7 +21689,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21692,7 +21710,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21713,7 +21731,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21734,7 +21752,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21755,7 +21773,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21776,7 +21794,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21797,7 +21815,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21818,7 +21836,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21839,7 +21857,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21860,7 +21878,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21881,7 +21899,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21902,7 +21920,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21923,7 +21941,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21944,7 +21962,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21965,7 +21983,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -21986,7 +22004,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22007,7 +22025,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22028,7 +22046,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22049,7 +22067,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22070,7 +22088,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22091,7 +22109,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22112,7 +22130,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22133,7 +22151,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22154,7 +22172,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22175,7 +22193,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22196,7 +22214,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22217,7 +22235,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22238,7 +22256,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22259,7 +22277,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22280,7 +22298,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22301,7 +22319,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22322,7 +22340,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22343,7 +22361,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22364,7 +22382,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22385,7 +22403,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22406,7 +22424,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22427,7 +22445,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22448,7 +22466,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22469,7 +22487,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22490,7 +22508,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22511,7 +22529,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22532,7 +22550,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22553,7 +22571,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22574,7 +22592,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22595,7 +22613,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22616,7 +22634,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22637,7 +22655,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22658,7 +22676,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22679,7 +22697,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22700,7 +22718,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22721,7 +22739,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22742,7 +22760,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22763,7 +22781,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22784,7 +22802,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22805,7 +22823,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22826,7 +22844,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22847,7 +22865,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22868,7 +22886,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22889,7 +22907,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22910,7 +22928,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22931,7 +22949,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22952,7 +22970,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22973,7 +22991,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -22994,7 +23012,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23015,7 +23033,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23036,7 +23054,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23057,7 +23075,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23078,7 +23096,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23099,7 +23117,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23120,7 +23138,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23141,7 +23159,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23162,7 +23180,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23183,7 +23201,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23204,7 +23222,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23225,7 +23243,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23246,7 +23264,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23267,7 +23285,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23288,7 +23306,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23309,7 +23327,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23330,7 +23348,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23351,7 +23369,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23372,7 +23390,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23393,7 +23411,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23414,7 +23432,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23435,7 +23453,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23456,7 +23474,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23477,7 +23495,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23498,7 +23516,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23519,7 +23537,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23540,7 +23558,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23561,7 +23579,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23582,7 +23600,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23603,7 +23621,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23624,7 +23642,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23645,7 +23663,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23666,7 +23684,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23687,7 +23705,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23708,7 +23726,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23729,7 +23747,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23750,7 +23768,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23771,7 +23789,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23792,7 +23810,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23813,7 +23831,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23834,7 +23852,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23855,7 +23873,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23876,7 +23894,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23897,7 +23915,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23918,7 +23936,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23939,7 +23957,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23960,7 +23978,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -23981,7 +23999,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24002,7 +24020,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24023,7 +24041,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24044,7 +24062,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24065,7 +24083,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24086,7 +24104,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24107,7 +24125,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24128,7 +24146,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24149,7 +24167,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24170,7 +24188,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24191,7 +24209,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24212,7 +24230,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24233,7 +24251,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24254,7 +24272,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24275,7 +24293,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24296,7 +24314,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24317,7 +24335,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24338,7 +24356,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24359,7 +24377,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24380,7 +24398,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24401,7 +24419,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24422,7 +24440,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24443,7 +24461,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24464,7 +24482,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24485,7 +24503,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24506,7 +24524,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24527,7 +24545,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24548,7 +24566,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24569,7 +24587,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24590,7 +24608,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24611,7 +24629,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24632,7 +24650,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24653,7 +24671,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24674,7 +24692,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24695,7 +24713,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24716,7 +24734,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24737,7 +24755,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24758,7 +24776,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24779,7 +24797,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24800,7 +24818,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24821,7 +24839,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24842,7 +24860,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24863,7 +24881,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24884,7 +24902,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24905,7 +24923,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24926,7 +24944,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24947,7 +24965,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24968,7 +24986,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -24989,7 +25007,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25010,7 +25028,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25031,7 +25049,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25052,7 +25070,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25073,7 +25091,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25094,7 +25112,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25115,7 +25133,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25136,7 +25154,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25157,7 +25175,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25178,7 +25196,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25199,7 +25217,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25220,7 +25238,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25241,7 +25259,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25262,7 +25280,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25283,7 +25301,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25304,7 +25322,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25325,7 +25343,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25346,7 +25364,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25367,7 +25385,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25388,7 +25406,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25409,7 +25427,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25430,7 +25448,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25451,7 +25469,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25472,7 +25490,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25493,7 +25511,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25514,7 +25532,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25535,7 +25553,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25556,7 +25574,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25577,7 +25595,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25598,7 +25616,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25619,7 +25637,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25640,7 +25658,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25661,7 +25679,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25682,7 +25700,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25703,7 +25721,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25724,7 +25742,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25745,7 +25763,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25766,7 +25784,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25787,7 +25805,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25808,7 +25826,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25829,7 +25847,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25850,7 +25868,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25871,7 +25889,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25892,7 +25910,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25913,7 +25931,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25934,7 +25952,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25955,7 +25973,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25976,7 +25994,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -25997,7 +26015,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26018,7 +26036,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26039,7 +26057,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26060,7 +26078,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26081,7 +26099,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26102,7 +26120,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26123,7 +26141,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26144,7 +26162,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26165,7 +26183,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26186,7 +26204,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26207,7 +26225,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26228,7 +26246,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26249,7 +26267,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26270,7 +26288,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26291,7 +26309,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26312,7 +26330,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26333,7 +26351,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26354,7 +26372,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26375,7 +26393,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26396,7 +26414,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26417,7 +26435,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26438,7 +26456,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26459,7 +26477,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26480,7 +26498,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26501,7 +26519,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26522,7 +26540,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26543,7 +26561,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26564,7 +26582,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26585,7 +26603,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26606,7 +26624,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26627,7 +26645,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26648,7 +26666,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26669,7 +26687,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26690,7 +26708,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26711,7 +26729,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26732,7 +26750,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26753,7 +26771,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26774,7 +26792,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26795,7 +26813,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26816,7 +26834,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26837,7 +26855,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26858,7 +26876,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26879,7 +26897,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26900,7 +26918,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26921,7 +26939,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26942,7 +26960,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26963,7 +26981,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -26984,7 +27002,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27005,7 +27023,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27026,7 +27044,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27047,7 +27065,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27068,7 +27086,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27089,7 +27107,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27110,7 +27128,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27131,7 +27149,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27152,7 +27170,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27173,7 +27191,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27194,7 +27212,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27215,7 +27233,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27236,7 +27254,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27257,7 +27275,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27278,7 +27296,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27299,7 +27317,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27320,7 +27338,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27341,7 +27359,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27362,7 +27380,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27383,7 +27401,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27404,7 +27422,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27425,7 +27443,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27446,7 +27464,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27467,7 +27485,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27488,7 +27506,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27509,7 +27527,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27530,7 +27548,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27551,7 +27569,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27572,7 +27590,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27593,7 +27611,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27614,7 +27632,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27635,7 +27653,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27656,7 +27674,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27677,7 +27695,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27698,7 +27716,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27719,7 +27737,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27740,7 +27758,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27761,7 +27779,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27782,7 +27800,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27803,7 +27821,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27824,7 +27842,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27845,7 +27863,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27866,7 +27884,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27887,7 +27905,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27908,7 +27926,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27929,7 +27947,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27950,7 +27968,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27971,7 +27989,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -27992,7 +28010,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28013,7 +28031,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28034,7 +28052,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28055,7 +28073,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28076,7 +28094,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28097,7 +28115,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28118,7 +28136,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28139,7 +28157,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28160,7 +28178,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28181,7 +28199,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28202,7 +28220,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28223,7 +28241,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28244,7 +28262,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28265,7 +28283,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28286,7 +28304,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28307,7 +28325,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28328,7 +28346,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28349,7 +28367,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28370,7 +28388,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28391,7 +28409,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28412,7 +28430,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28433,7 +28451,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28454,7 +28472,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28475,7 +28493,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28496,7 +28514,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28517,7 +28535,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28538,7 +28556,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28559,7 +28577,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28580,7 +28598,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28601,7 +28619,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28622,7 +28640,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28643,7 +28661,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28664,7 +28682,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28685,7 +28703,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28706,7 +28724,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28727,7 +28745,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28748,7 +28766,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28769,7 +28787,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28790,7 +28808,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28811,7 +28829,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28832,7 +28850,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28853,7 +28871,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28874,7 +28892,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28895,7 +28913,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28916,7 +28934,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28937,7 +28955,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28958,7 +28976,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -28979,7 +28997,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29000,7 +29018,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29021,7 +29039,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29042,7 +29060,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29063,7 +29081,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29084,7 +29102,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29105,7 +29123,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29126,7 +29144,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29147,7 +29165,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29168,7 +29186,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29189,7 +29207,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29210,7 +29228,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29231,7 +29249,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29252,7 +29270,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29273,7 +29291,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29294,7 +29312,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29315,7 +29333,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29336,7 +29354,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29357,7 +29375,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29378,7 +29396,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29399,7 +29417,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29420,7 +29438,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29441,7 +29459,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29462,7 +29480,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29483,7 +29501,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29504,7 +29522,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29525,7 +29543,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29546,7 +29564,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29567,7 +29585,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29588,7 +29606,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29609,7 +29627,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29630,7 +29648,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29651,7 +29669,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29672,7 +29690,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29693,7 +29711,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29714,7 +29732,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29735,7 +29753,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29756,7 +29774,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29777,7 +29795,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29798,7 +29816,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29819,7 +29837,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29840,7 +29858,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29861,7 +29879,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29882,7 +29900,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29903,7 +29921,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29924,7 +29942,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29945,7 +29963,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29966,7 +29984,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -29987,7 +30005,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30008,7 +30026,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30029,7 +30047,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30050,7 +30068,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30071,7 +30089,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30092,7 +30110,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30113,7 +30131,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30134,7 +30152,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30155,7 +30173,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30176,7 +30194,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30197,7 +30215,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30218,7 +30236,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30239,7 +30257,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30260,7 +30278,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30281,7 +30299,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30302,7 +30320,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30323,7 +30341,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30344,7 +30362,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30365,7 +30383,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30386,7 +30404,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30407,7 +30425,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30428,7 +30446,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30449,7 +30467,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30470,7 +30488,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30491,7 +30509,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30512,7 +30530,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30533,7 +30551,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30554,7 +30572,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30575,7 +30593,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30596,7 +30614,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30617,7 +30635,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30638,7 +30656,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30659,7 +30677,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30680,7 +30698,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30701,7 +30719,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30722,7 +30740,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30743,7 +30761,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30764,7 +30782,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30785,7 +30803,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30806,7 +30824,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30827,7 +30845,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30848,7 +30866,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30869,7 +30887,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30890,7 +30908,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30911,7 +30929,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30932,7 +30950,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30953,7 +30971,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30974,7 +30992,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -30995,7 +31013,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31016,7 +31034,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31037,7 +31055,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31058,7 +31076,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31079,7 +31097,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31100,7 +31118,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31121,7 +31139,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31142,7 +31160,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31163,7 +31181,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31184,7 +31202,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31205,7 +31223,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31226,7 +31244,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31247,7 +31265,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31268,7 +31286,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31289,7 +31307,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31310,7 +31328,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31331,7 +31349,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31352,7 +31370,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31373,7 +31391,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31394,7 +31412,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31415,7 +31433,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31436,7 +31454,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31457,7 +31475,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31478,7 +31496,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31499,7 +31517,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31520,7 +31538,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31541,7 +31559,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31562,7 +31580,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31583,7 +31601,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31604,7 +31622,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31625,7 +31643,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31646,7 +31664,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31667,7 +31685,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31688,7 +31706,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31709,7 +31727,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31730,7 +31748,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31751,7 +31769,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31772,7 +31790,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31793,7 +31811,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31814,7 +31832,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31835,7 +31853,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31856,7 +31874,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31877,7 +31895,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31898,7 +31916,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31919,7 +31937,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31940,7 +31958,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31961,7 +31979,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -31982,7 +32000,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32003,7 +32021,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32024,7 +32042,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32045,7 +32063,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32066,7 +32084,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32087,7 +32105,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32108,7 +32126,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32129,7 +32147,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32150,7 +32168,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32171,7 +32189,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32192,7 +32210,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32213,7 +32231,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32234,7 +32252,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32255,7 +32273,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32276,7 +32294,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32297,7 +32315,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32318,7 +32336,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32339,7 +32357,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32360,7 +32378,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32381,7 +32399,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32402,7 +32420,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32423,7 +32441,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32444,7 +32462,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32465,7 +32483,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32486,7 +32504,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32507,7 +32525,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32528,7 +32546,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32549,7 +32567,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32570,7 +32588,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32591,7 +32609,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32612,7 +32630,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32633,7 +32651,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32654,7 +32672,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32675,7 +32693,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32696,7 +32714,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32717,7 +32735,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32738,7 +32756,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32759,7 +32777,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32780,7 +32798,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32801,7 +32819,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32822,7 +32840,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32843,7 +32861,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32864,7 +32882,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32885,7 +32903,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32906,7 +32924,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32927,7 +32945,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32948,7 +32966,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32969,7 +32987,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -32990,7 +33008,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33011,7 +33029,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33032,7 +33050,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33053,7 +33071,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33074,7 +33092,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33095,7 +33113,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33116,7 +33134,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33137,7 +33155,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33158,7 +33176,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33179,7 +33197,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33200,7 +33218,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33221,7 +33239,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33242,7 +33260,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33263,7 +33281,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33284,7 +33302,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33305,7 +33323,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33326,7 +33344,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33347,7 +33365,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33368,7 +33386,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33389,7 +33407,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33410,7 +33428,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33431,7 +33449,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33452,7 +33470,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33473,7 +33491,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33494,7 +33512,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33515,7 +33533,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33536,7 +33554,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33557,7 +33575,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33578,7 +33596,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33599,7 +33617,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33620,7 +33638,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33641,7 +33659,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33662,7 +33680,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33683,7 +33701,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33704,7 +33722,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33725,7 +33743,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33746,7 +33764,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33767,7 +33785,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33788,7 +33806,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33809,7 +33827,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33830,7 +33848,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33851,7 +33869,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33872,7 +33890,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33893,7 +33911,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33914,7 +33932,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33935,7 +33953,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33956,7 +33974,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33977,7 +33995,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -33998,7 +34016,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34019,7 +34037,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34040,7 +34058,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34061,7 +34079,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34082,7 +34100,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34103,7 +34121,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34124,7 +34142,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34145,7 +34163,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34166,7 +34184,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34187,7 +34205,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34208,7 +34226,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34229,7 +34247,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34250,7 +34268,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34271,7 +34289,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34292,7 +34310,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34313,7 +34331,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34334,7 +34352,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34355,7 +34373,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34376,7 +34394,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34397,7 +34415,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34418,7 +34436,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34439,7 +34457,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34460,7 +34478,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34481,7 +34499,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34502,7 +34520,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34523,7 +34541,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34544,7 +34562,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34565,7 +34583,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34586,7 +34604,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34607,7 +34625,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34628,7 +34646,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34649,7 +34667,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34670,7 +34688,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34691,7 +34709,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34712,7 +34730,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34733,7 +34751,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34754,7 +34772,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34775,7 +34793,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34796,7 +34814,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34817,7 +34835,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34838,7 +34856,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34859,7 +34877,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34880,7 +34898,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34901,7 +34919,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34922,7 +34940,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34943,7 +34961,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34964,7 +34982,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -34985,7 +35003,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35006,7 +35024,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35027,7 +35045,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35048,7 +35066,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35069,7 +35087,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35090,7 +35108,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35111,7 +35129,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35132,7 +35150,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35153,7 +35171,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35174,7 +35192,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35195,7 +35213,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35216,7 +35234,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35237,7 +35255,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35258,7 +35276,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35279,7 +35297,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35300,7 +35318,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35321,7 +35339,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35342,7 +35360,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35363,7 +35381,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35384,7 +35402,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35405,7 +35423,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35426,7 +35444,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35447,7 +35465,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35468,7 +35486,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35489,7 +35507,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35510,7 +35528,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35531,7 +35549,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35552,7 +35570,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35573,7 +35591,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35594,7 +35612,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35615,7 +35633,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35636,7 +35654,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35657,7 +35675,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35678,7 +35696,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35699,7 +35717,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35720,7 +35738,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35741,7 +35759,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35762,7 +35780,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35783,7 +35801,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35804,7 +35822,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35825,7 +35843,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35846,7 +35864,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35867,7 +35885,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35888,7 +35906,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35909,7 +35927,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35930,7 +35948,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35951,7 +35969,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35972,7 +35990,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -35993,7 +36011,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36014,7 +36032,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36035,7 +36053,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36056,7 +36074,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36077,7 +36095,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36098,7 +36116,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36119,7 +36137,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36140,7 +36158,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36161,7 +36179,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36182,7 +36200,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36203,7 +36221,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36224,7 +36242,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36245,7 +36263,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36266,7 +36284,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36287,7 +36305,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36308,7 +36326,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36329,7 +36347,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36350,7 +36368,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36371,7 +36389,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36392,7 +36410,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36413,7 +36431,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36434,7 +36452,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36455,7 +36473,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36476,7 +36494,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36497,7 +36515,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36518,7 +36536,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36539,7 +36557,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36560,7 +36578,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36581,7 +36599,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36602,7 +36620,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36623,7 +36641,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36644,7 +36662,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36665,7 +36683,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36686,7 +36704,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36707,7 +36725,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36728,7 +36746,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36749,7 +36767,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36770,7 +36788,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36791,7 +36809,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36812,7 +36830,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36833,7 +36851,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36854,7 +36872,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36875,7 +36893,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36896,7 +36914,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36917,7 +36935,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36938,7 +36956,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36959,7 +36977,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -36980,7 +36998,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37001,7 +37019,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37022,7 +37040,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37043,7 +37061,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37064,7 +37082,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37085,7 +37103,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37106,7 +37124,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37127,7 +37145,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37148,7 +37166,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37169,7 +37187,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37190,7 +37208,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37211,7 +37229,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37232,7 +37250,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37253,7 +37271,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37274,7 +37292,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37295,7 +37313,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37316,7 +37334,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37337,7 +37355,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37358,7 +37376,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37379,7 +37397,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37400,7 +37418,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37421,7 +37439,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37442,7 +37460,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37463,7 +37481,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37484,7 +37502,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37505,7 +37523,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37526,7 +37544,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37547,7 +37565,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37568,7 +37586,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37589,7 +37607,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37610,7 +37628,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37631,7 +37649,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37652,7 +37670,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37673,7 +37691,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37694,7 +37712,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37715,7 +37733,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37736,7 +37754,7 @@ VALUES
     );
 
 INSERT INTO
-    questoes (
+    carga_questoes (
         pergunta,
         alternativa_a,
         alternativa_b,
@@ -37754,4 +37772,22 @@ VALUES
         'Mitigation may include the use of acoustic deterrents, modifications or switching in equipment (for example, gillnets for hook and line) and the closure of areas or during periods of time for fishing.',
         'The global moratorium on all large-scale pelagic drift-net fishing called for by the General Assembly in 1991 was a major step in limiting the by-catch of several marine mammal and seabird species',
         "D"
-    );
\ No newline at end of file
+    );
+
+-- Passa as questões carregadas para o formato com a tabela alternativas.
+INSERT INTO questoes (id, pergunta, tipo)
+SELECT id, pergunta, 'unica' FROM carga_questoes ORDER BY id;
+
+INSERT INTO alternativas (questao_id, posicao, texto, correta)
+SELECT id, 1, alternativa_a, correta = 'A' FROM carga_questoes
+UNION ALL SELECT id, 2, alternativa_b, correta = 'B' FROM carga_questoes
+UNION ALL SELECT id, 3, alternativa_c, correta = 'C' FROM carga_questoes
+UNION ALL SELECT id, 4, alternativa_d, correta = 'D' FROM carga_questoes
+UNION ALL SELECT id, 5, alternativa_e, correta = 'E' FROM carga_questoes;
+
+UPDATE questoes
+SET fonte = 'Pirá',
+    atribuicao = 'Pirá: A Bilingual Portuguese-English Dataset for Question-Answering about the Ocean (Paschoal et al., 2021), CC BY 4.0'
+WHERE id IN (SELECT id FROM carga_questoes);
+
+DROP TEMPORARY TABLE carga_questoes;
@@ -0,0 +1,335 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Classificação das questões. Tópicos são uma lista curada (criada pelos
+// admins) usada para montar quizzes por assunto; tags são livres e criadas
+// na hora em que aparecem numa questão. As questões guardam os slugs dos
+// tópicos e os nomes das tags.
+
+const tamanhoMaximoTag = 64
+
+var slugValido = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+type TopicoData struct {
+	Slug string `json:"slug"`
+	Nome string `json:"nome"`
+}
+
+type Topico struct {
+	Slug     string `json:"slug"`
+	Nome     string `json:"nome"`
+	Questoes *int   `json:"questoes,omitempty"`
+}
+
+// execer é o que *sql.DB e *sql.Tx têm em comum, para as funções que rodam
+// dentro ou fora de uma transação.
+type execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+}
+
+type errTopicoDesconhecido string
+
+func (e errTopicoDesconhecido) Error() string {
+	return fmt.Sprintf("tópico desconhecido: %v", string(e))
+}
+
+// normalizarClassificacao deixa slugs e tags em minúsculas, sem repetição e
+// em ordem, e devolve a mensagem de erro, ou "".
+func normalizarClassificacao(topicos, tags []string) ([]string, []string, string) {
+	normalizar := func(lista []string) []string {
+		saida := []string{}
+		for _, s := range lista {
+			if s = strings.ToLower(strings.TrimSpace(s)); s != "" {
+				saida = append(saida, s)
+			}
+		}
+		slices.Sort(saida)
+		return slices.Compact(saida)
+	}
+
+	topicos, tags = normalizar(topicos), normalizar(tags)
+	for _, t := range topicos {
+		if !slugValido.MatchString(t) {
+			return nil, nil, fmt.Sprintf("Tópico inválido: %v", t)
+		}
+	}
+	for _, t := range tags {
+		if utf8.RuneCountInString(t) > tamanhoMaximoTag || strings.Contains(t, "|") {
+			return nil, nil, fmt.Sprintf("Tag inválida: %v", t)
+		}
+	}
+	return topicos, tags, ""
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+func paraArgs(lista []string) []any {
+	args := make([]any, len(lista))
+	for i := range lista {
+		args[i] = lista[i]
+	}
+	return args
+}
+
+// salvarClassificacao troca os tópicos e tags da questão pelos informados.
+func salvarClassificacao(db execer, questaoID int, topicos, tags []string) error {
+	if _, err := db.Exec("DELETE FROM questoes_topicos WHERE questao_id = ?", questaoID); err != nil {
+		return err
+	}
+	if _, err := db.Exec("DELETE FROM questoes_tags WHERE questao_id = ?", questaoID); err != nil {
+		return err
+	}
+
+	if len(topicos) > 0 {
+		res, err := db.Exec("INSERT INTO questoes_topicos (questao_id, topico_id) SELECT ?, id FROM topicos WHERE slug IN ("+placeholders(len(topicos))+")",
+			append([]any{questaoID}, paraArgs(topicos)...)...)
+		if err != nil {
+			return err
+		}
+		if n, _ := res.RowsAffected(); int(n) != len(topicos) {
+			existentes, err := slugsExistentes(db)
+			if err != nil {
+				return err
+			}
+			for _, t := range topicos {
+				if !existentes[t] {
+					return errTopicoDesconhecido(t)
+				}
+			}
+		}
+	}
+
+	if len(tags) > 0 {
+		valores := strings.TrimSuffix(strings.Repeat("(?), ", len(tags)), ", ")
+		if _, err := db.Exec("INSERT IGNORE INTO tags (nome) VALUES "+valores, paraArgs(tags)...); err != nil {
+			return err
+		}
+		_, err := db.Exec("INSERT INTO questoes_tags (questao_id, tag_id) SELECT ?, id FROM tags WHERE nome IN ("+placeholders(len(tags))+")",
+			append([]any{questaoID}, paraArgs(tags)...)...)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func slugsExistentes(db execer) (map[string]bool, error) {
+	rows, err := db.Query("SELECT slug FROM topicos")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	slugs := map[string]bool{}
+	for rows.Next() {
+		var s string
+		if err := rows.Scan(&s); err != nil {
+			return nil, err
+		}
+		slugs[s] = true
+	}
+	return slugs, rows.Err()
+}
+
+// carregarClassificacao busca os slugs dos tópicos e os nomes das tags das
+// questões informadas (todas, se ids for nil).
+func carregarClassificacao(db execer, ids []int) (map[int][]string, map[int][]string, error) {
+	topicos := map[int][]string{}
+	tags := map[int][]string{}
+
+	filtro, args := "", []any{}
+	if ids != nil {
+		if len(ids) == 0 {
+			return topicos, tags, nil
+		}
+		filtro = " WHERE l.questao_id IN (" + placeholders(len(ids)) + ")"
+		for _, id := range ids {
+			args = append(args, id)
+		}
+	}
+
+	for _, c := range []struct {
+		query   string
+		destino map[int][]string
+	}{
+		{"SELECT l.questao_id, t.slug FROM questoes_topicos l JOIN topicos t ON t.id = l.topico_id" + filtro + " ORDER BY t.slug", topicos},
+		{"SELECT l.questao_id, t.nome FROM questoes_tags l JOIN tags t ON t.id = l.tag_id" + filtro + " ORDER BY t.nome", tags},
+	} {
+		rows, err := db.Query(c.query, args...)
+		if err != nil {
+			return nil, nil, err
+		}
+		for rows.Next() {
+			var id int
+			var nome string
+			if err := rows.Scan(&id, &nome); err != nil {
+				rows.Close()
+				return nil, nil, err
+			}
+			c.destino[id] = append(c.destino[id], nome)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, nil, err
+		}
+	}
+	return topicos, tags, nil
+}
+
+// topicosDasQuestoes devolve os tópicos com o nome, para mostrar ao usuário.
+func topicosDasQuestoes(db execer, ids []int) (map[int][]Topico, error) {
+	topicos := map[int][]Topico{}
+	if len(ids) == 0 {
+		return topicos, nil
+	}
+
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	rows, err := db.Query("SELECT qt.questao_id, t.slug, t.nome FROM questoes_topicos qt JOIN topicos t ON t.id = qt.topico_id WHERE qt.questao_id IN ("+placeholders(len(ids))+") ORDER BY t.nome", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int
+		var t Topico
+		if err := rows.Scan(&id, &t.Slug, &t.Nome); err != nil {
+			return nil, err
+		}
+		topicos[id] = append(topicos[id], t)
+	}
+	return topicos, rows.Err()
+}
+
+// filtroTopicos monta a condição para as buscas de questões que aceitam
+// ?topico= (um ou mais slugs; a questão precisa ter pelo menos um deles).
+// coluna é a coluna com o id da questão na consulta.
+func filtroTopicos(coluna string, slugs []string) (string, []any) {
+	return "EXISTS (SELECT 1 FROM questoes_topicos qt JOIN topicos t ON t.id = qt.topico_id WHERE qt.questao_id = " + coluna +
+		" AND t.slug IN (" + placeholders(len(slugs)) + "))", paraArgs(slugs)
+}
+
+// topicosDaQuery lê ?topico=a&topico=b ou ?topico=a,b.
+func topicosDaQuery(r *http.Request) []string {
+	var slugs []string
+	for _, v := range r.URL.Query()["topico"] {
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.ToLower(strings.TrimSpace(s)); s != "" {
+				slugs = append(slugs, s)
+			}
+		}
+	}
+	return slugs
+}
+
+func listarTopicos(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodGet {
+		w.WriteHeader(406)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	rows, err := conn.Query(`
+    SELECT t.slug, t.nome, COUNT(q.id)
+    FROM topicos t
+    LEFT JOIN questoes_topicos qt ON qt.topico_id = t.id
+    LEFT JOIN questoes q ON q.id = qt.questao_id AND q.removida_em IS NULL
+    GROUP BY t.id
+    ORDER BY t.nome`)
+	if err != nil {
+		logger.Println("[e] Erro ao listar tópicos:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	defer rows.Close()
+
+	topicos := []Topico{}
+	for rows.Next() {
+		var t Topico
+		var n int
+		if err := rows.Scan(&t.Slug, &t.Nome, &n); err != nil {
+			logger.Println("[e] Erro ao ler tópico:", err)
+			enviarErrorJson(w, "Algo deu errado", 500)
+			return
+		}
+		t.Questoes = &n
+		topicos = append(topicos, t)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Println("[e] Erro ao listar tópicos:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	enviarRespostaJson(w, topicos, 200)
+}
+
+func criarTopico(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodPost {
+		w.WriteHeader(406)
+		return
+	}
+	usuario := usuarioDoContexto(r)
+	var t TopicoData
+
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	err := d.Decode(&t)
+
+	t.Slug = strings.TrimSpace(t.Slug)
+	t.Nome = strings.TrimSpace(t.Nome)
+	if err != nil || d.More() {
+		enviarErrorJson(w, "Estrutura do JSON incorreta.", 400)
+		return
+	}
+	if !slugValido.MatchString(t.Slug) || len(t.Slug) > 64 {
+		enviarErrorJson(w, "Slug inválido: use letras minúsculas, números e hífens", 400)
+		return
+	}
+	if !validarNome(t.Nome) {
+		enviarErrorJson(w, "Nome deve ter entre 2 e 255 caracteres", 400)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	if _, err := conn.Exec("INSERT INTO topicos (slug, nome) VALUES (?, ?)", t.Slug, t.Nome); err != nil {
+		if ehChaveDuplicada(err) {
+			enviarErrorJson(w, "Já existe um tópico com esse slug", 409)
+			return
+		}
+		logger.Println("[e] Erro ao criar tópico:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	logger.Printf("[i] tópico %v criado por %v\n", t.Slug, autorDaRequisicao(usuario))
+	enviarRespostaJson(w, Topico{Slug: t.Slug, Nome: t.Nome}, 201)
+}
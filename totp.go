@@ -0,0 +1,91 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TOTP (RFC 6238) com os parâmetros que todo app autenticador entende:
+// HMAC-SHA1, 6 dígitos, passos de 30 segundos.
+
+const (
+	passoTOTP      = 30 * time.Second
+	digitosTOTP    = 6
+	toleranciaTOTP = 1 // passos aceitos antes/depois do atual (relógio do celular)
+	emissorTOTP    = "Brain Quest"
+)
+
+var base32SemPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func gerarSegredoTOTP() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base32SemPadding.EncodeToString(b), nil
+}
+
+func uriTOTP(segredo, conta string) string {
+	q := url.Values{}
+	q.Set("secret", segredo)
+	q.Set("issuer", emissorTOTP)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", fmt.Sprint(digitosTOTP))
+	q.Set("period", fmt.Sprint(int(passoTOTP.Seconds())))
+
+	rotulo := url.PathEscape(emissorTOTP + ":" + conta)
+	return fmt.Sprintf("otpauth://totp/%s?%s", rotulo, q.Encode())
+}
+
+func codigoTOTP(segredo string, passo int64) (string, error) {
+	chave, err := base32SemPadding.DecodeString(strings.ToUpper(segredo))
+	if err != nil {
+		return "", err
+	}
+
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(passo))
+
+	mac := hmac.New(sha1.New, chave)
+	mac.Write(msg[:])
+	soma := mac.Sum(nil)
+
+	// truncamento dinâmico (RFC 4226, seção 5.3)
+	offset := soma[len(soma)-1] & 0x0f
+	binario := binary.BigEndian.Uint32(soma[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for range digitosTOTP {
+		modulo *= 10
+	}
+	return fmt.Sprintf("%0*d", digitosTOTP, binario%modulo), nil
+}
+
+// passoTOTPValido devolve o passo em que o código bate, dentro da tolerância.
+func passoTOTPValido(segredo, codigo string, agora time.Time) (int64, bool) {
+	codigo = strings.TrimSpace(codigo)
+	if len(codigo) != digitosTOTP {
+		return 0, false
+	}
+
+	atual := agora.Unix() / int64(passoTOTP.Seconds())
+	for delta := -toleranciaTOTP; delta <= toleranciaTOTP; delta++ {
+		passo := atual + int64(delta)
+		esperado, err := codigoTOTP(segredo, passo)
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(esperado), []byte(codigo)) == 1 {
+			return passo, true
+		}
+	}
+	return 0, false
+}
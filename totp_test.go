@@ -0,0 +1,131 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// segredo dos vetores de teste da RFC 6238 (SHA1): "12345678901234567890"
+var segredoRFC6238 = base32SemPadding.EncodeToString([]byte("12345678901234567890"))
+
+// Apêndice B da RFC 6238. Os vetores têm 8 dígitos; com 6 fica o final.
+func TestCodigoTOTPRFC6238(t *testing.T) {
+	casos := []struct {
+		segundos int64
+		rfc      string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+		{20000000000, "65353130"},
+	}
+
+	for _, c := range casos {
+		t.Run(fmt.Sprint(c.segundos), func(t *testing.T) {
+			codigo, err := codigoTOTP(segredoRFC6238, c.segundos/int64(passoTOTP.Seconds()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if esperado := c.rfc[len(c.rfc)-digitosTOTP:]; codigo != esperado {
+				t.Errorf("código %v, esperava %v", codigo, esperado)
+			}
+		})
+	}
+}
+
+func TestPassoTOTPValido(t *testing.T) {
+	agora := time.Unix(1111111111, 0)
+	atual := agora.Unix() / int64(passoTOTP.Seconds())
+	codigoDe := func(passo int64) string {
+		codigo, err := codigoTOTP(segredoRFC6238, passo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return codigo
+	}
+
+	casos := []struct {
+		nome   string
+		codigo string
+		passo  int64
+		valido bool
+	}{
+		{"passo atual", codigoDe(atual), atual, true},
+		{"com espaços", " " + codigoDe(atual) + " ", atual, true},
+		{"passo anterior (relógio atrasado)", codigoDe(atual - 1), atual - 1, true},
+		{"passo seguinte (relógio adiantado)", codigoDe(atual + 1), atual + 1, true},
+		{"dois passos atrás", codigoDe(atual - 2), 0, false},
+		{"dois passos à frente", codigoDe(atual + 2), 0, false},
+		{"código errado", "000000", 0, false},
+		{"curto", codigoDe(atual)[1:], 0, false},
+		{"oito dígitos da RFC", "14050471", 0, false},
+		{"vazio", "", 0, false},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			passo, ok := passoTOTPValido(segredoRFC6238, c.codigo, agora)
+			if ok != c.valido || passo != c.passo {
+				t.Errorf("passoTOTPValido = (%v, %v), esperava (%v, %v)", passo, ok, c.passo, c.valido)
+			}
+		})
+	}
+}
+
+func TestSegredoTOTPInvalido(t *testing.T) {
+	if _, ok := passoTOTPValido("não é base32!", "123456", time.Now()); ok {
+		t.Error("segredo inválido aceitou um código")
+	}
+}
+
+// Um código só vale uma vez, e depois dele os passos anteriores (ainda dentro
+// da tolerância) também não valem mais.
+func TestRegistrarPassoTOTP(t *testing.T) {
+	casos := []struct {
+		nome   string
+		passos []int64 // usados em ordem
+		aceito []bool
+	}{
+		{"primeiro uso", []int64{100}, []bool{true}},
+		{"mesmo passo de novo", []int64{100, 100}, []bool{true, false}},
+		{"passo anterior depois do atual", []int64{100, 99}, []bool{true, false}},
+		{"passo seguinte", []int64{100, 101}, []bool{true, true}},
+		{"anterior, atual e seguinte", []int64{99, 100, 100, 101}, []bool{true, true, false, true}},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			redisDeTeste(t)
+			for i, passo := range c.passos {
+				aceito, err := registrarPassoTOTP("usuario-1", passo)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if aceito != c.aceito[i] {
+					t.Errorf("passo %v (uso %v): aceito = %v, esperava %v", passo, i+1, aceito, c.aceito[i])
+				}
+			}
+		})
+	}
+}
+
+// O passo usado por um usuário não atrapalha outro, e o registro some depois
+// da janela de tolerância.
+func TestRegistrarPassoTOTPIsolado(t *testing.T) {
+	m := redisDeTeste(t)
+
+	if ok, _ := registrarPassoTOTP("usuario-1", 100); !ok {
+		t.Fatal("primeiro uso recusado")
+	}
+	if ok, _ := registrarPassoTOTP("usuario-2", 100); !ok {
+		t.Error("o mesmo passo foi recusado para outro usuário")
+	}
+
+	m.FastForward(time.Duration(2*toleranciaTOTP+1) * passoTOTP)
+	if m.Exists(chaveUltimoPassoTOTP("usuario-1")) {
+		t.Error("o último passo não expirou")
+	}
+}
@@ -3,14 +3,10 @@ package main
 import (
 	"database/sql"
 	"encoding/json"
-	"errors"
 	"net/http"
 	"net/mail"
-	"os"
-	"time"
+	"strings"
 
-	paseto "aidanwoods.dev/go-paseto"
-	"github.com/go-sql-driver/mysql"
 	"github.com/google/uuid"
 	"github.com/paemuri/brdoc"
 	"golang.org/x/crypto/bcrypt"
@@ -45,7 +41,12 @@ func registrar(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(novoUsuario.Senha), bcrypt.DefaultCost)
+	if msg := validarSenha(novoUsuario.Senha, novoUsuario.Email, novoUsuario.Cpf); msg != "" {
+		enviarErroCodigo(w, codigoSenhaFraca, msg, 400)
+		return
+	}
+
+	hashedPassword, err := gerarHashSenha(novoUsuario.Senha)
 	if err != nil {
 		enviarErrorJson(w, "Falha ao criar hash do password", 500)
 		return
@@ -53,6 +54,14 @@ func registrar(w http.ResponseWriter, r *http.Request) {
 
 	ruuid := uuid.New().String()
 
+	cpf := normalizarCPF(novoUsuario.Cpf)
+	cpfCifrado, err := cifrar(cpf)
+	if err != nil {
+		logger.Println("[e] Erro ao cifrar CPF:", err)
+		enviarErrorJson(w, "algo deu errado ao criar usuário", http.StatusInternalServerError)
+		return
+	}
+
 	conn, err := OpenConn()
 	if err != nil {
 		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
@@ -60,14 +69,11 @@ func registrar(w http.ResponseWriter, r *http.Request) {
 	}
 	defer conn.Close()
 
-	_, err = conn.Exec("INSERT INTO users (id, email, senha, cpf, nome, telefone) VALUES (?, ?, ?, ?, ?, ?)", ruuid, novoUsuario.Email, hashedPassword, novoUsuario.Cpf, novoUsuario.Username, novoUsuario.Telefone)
+	_, err = conn.Exec("INSERT INTO users (id, email, senha, cpf_cifrado, cpf_indice, nome, telefone) VALUES (?, ?, ?, ?, ?, ?, ?)", ruuid, novoUsuario.Email, hashedPassword, cpfCifrado, indiceCego(cpf), novoUsuario.Username, novoUsuario.Telefone)
 	if err != nil {
-		var mysqlErr *mysql.MySQLError
-		if errors.As(err, &mysqlErr) {
-			if mysqlErr.Number == 1062 { // ER_DUP_ENTRY
-				enviarErrorJson(w, "email ou cpf já cadastrado", http.StatusForbidden)
-				return
-			}
+		if ehChaveDuplicada(err) {
+			enviarErrorJson(w, "email ou cpf já cadastrado", http.StatusConflict)
+			return
 		}
 
 		logger.Println("[e] Erro ao inserir usuário:", err)
@@ -75,6 +81,10 @@ func registrar(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := enviarVerificacao(ruuid, novoUsuario.Email); err != nil {
+		logger.Printf("[w] falha ao enviar verificação de email para %v: %v\n", ruuid, err)
+	}
+
 	enviarRespostaJson(w, "ok", 200)
 
 }
@@ -101,8 +111,12 @@ type LoginData struct {
 }
 
 type LoginResponse struct {
-	Token   string `json:"token"`
-	Expires int64  `json:"expiration"`
+	Token          string `json:"token"`
+	Expires        int64  `json:"expiration"`
+	RefreshToken   string `json:"refresh_token"`
+	RefreshExpires int64  `json:"refresh_expiration"`
+	// só no login institucional, quando a conta ainda não tem CPF
+	PerfilIncompleto bool `json:"profile_incomplete,omitempty"`
 }
 
 func validarDadosLogin(r LoginData) bool {
@@ -135,6 +149,18 @@ func login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	ip := ipCliente(r)
+	espera, err := tempoBloqueioLogin(dadosLogin.Email, ip)
+	if err != nil {
+		logger.Println("[e] Erro ao verificar bloqueio de login:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	if espera > 0 {
+		enviarBloqueado(w, espera)
+		return
+	}
+
 	//buscar no pg
 	conn, err := OpenConn()
 	if err != nil {
@@ -144,9 +170,12 @@ func login(w http.ResponseWriter, r *http.Request) {
 	defer conn.Close()
 
 	var senhaSalva, uuidUsuario string
+	var papel Papel
+	var totpAtivo bool
 
-	err = conn.QueryRow("SELECT senha, id FROM users WHERE email = ?", dadosLogin.Email).Scan(&senhaSalva, &uuidUsuario)
+	err = conn.QueryRow("SELECT COALESCE(senha, ''), id, papel, totp_ativo FROM users WHERE email = ?", dadosLogin.Email).Scan(&senhaSalva, &uuidUsuario, &papel, &totpAtivo)
 	if err == sql.ErrNoRows {
+		registrarFalhaLogin(dadosLogin.Email, ip, "")
 		enviarErrorJson(w, "Usuário ou senha incorretas", 401)
 		return
 	} else if err != nil {
@@ -156,11 +185,41 @@ func login(w http.ResponseWriter, r *http.Request) {
 
 	err = bcrypt.CompareHashAndPassword([]byte(senhaSalva), []byte(dadosLogin.Password))
 	if err != nil {
+		registrarFalhaLogin(dadosLogin.Email, ip, uuidUsuario)
 		enviarErrorJson(w, "Usuário ou senha incorretas", 401)
 		return
 	}
+	atualizarCustoSenha(conn, uuidUsuario, []byte(senhaSalva), dadosLogin.Password)
+
+	//com 2FA, a sessão só sai depois do código em /login/2fa, e é lá que as
+	//falhas são zeradas e o dia é contado
+	if totpAtivo {
+		desafio, err := criarDesafio2FA(uuidUsuario, papel)
+		if err != nil {
+			logger.Println("[e] Erro ao criar desafio 2FA:", err)
+			enviarErrorJson(w, "Erro ao criar sessão", 500)
+			return
+		}
+		enviarRespostaJson(w, desafio, 200)
+		return
+	}
 
-	//atualiza o ultimo_login, e verifica o streak
+	limparFalhasLogin(dadosLogin.Email)
+	registrarDiaLogin(conn, uuidUsuario)
+
+	//devolver token
+	tokens, err := iniciarSessao(uuidUsuario, papel, r)
+	if err != nil {
+		logger.Println("[e] Erro ao iniciar sessão:", err)
+		enviarErrorJson(w, "Erro ao criar sessão", 500)
+		return
+	}
+
+	enviarRespostaJson(w, tokens, 200)
+}
+
+// registrarDiaLogin atualiza o ultimo_login e o streak de dias seguidos.
+func registrarDiaLogin(conn *sql.DB, userID string) {
 	if _, err := conn.Exec(`UPDATE dados
     SET 
       ultimo_login = CURDATE(),
@@ -170,32 +229,11 @@ func login(w http.ResponseWriter, r *http.Request) {
         ELSE 1
       END
     WHERE id = ?;`,
-		uuidUsuario,
+		userID,
 	); err != nil {
 		// se der errado, ignorar e continua o login
-		logger.Printf("[w] falha ao atualizar ultimo_login de %v: %v\n", uuidUsuario, err)
+		logger.Printf("[w] falha ao atualizar ultimo_login de %v: %v\n", userID, err)
 	}
-
-	//devolver token
-	token := paseto.NewToken()
-	//exp := time.Now()
-
-	token.SetIssuedAt(time.Now())
-	token.SetNotBefore(time.Now())
-	token.SetExpiration(time.Now().Add(36 * time.Hour))
-
-	token.SetString("id", uuidUsuario)
-
-	key, err := paseto.V4SymmetricKeyFromHex(os.Getenv("paseto_key"))
-	if err != nil {
-		enviarErrorJson(w, "Erro de chave", 500)
-		return
-	}
-
-	encrypted := token.V4Encrypt(key, nil)
-	exp, _ := token.GetExpiration()
-
-	enviarRespostaJson(w, LoginResponse{Token: encrypted, Expires: exp.Unix()}, 200)
 }
 
 type UserData struct {
@@ -204,10 +242,17 @@ type UserData struct {
 	CPF       string  `json:"cpf"`
 	Email     string  `json:"email"`
 	Telephone *string `json:"telephone,omitempty"`
+	Verified  bool    `json:"verified"`
+	Role      Papel   `json:"role"`
+	Exclusao  *int64  `json:"deletion_scheduled,omitempty"`
+	TOTP      bool    `json:"two_factor_enabled"`
+	Completo  bool    `json:"profile_complete"`
 	Questões  struct {
 		Respondidas       int      `json:"respondidas"`
 		Acertos           int      `json:"acertos"`
 		Erros             int      `json:"erros"`
+		Sequencia         int      `json:"sequencia_acertos"`
+		XP                int      `json:"xp"`
 		Dias              int      `json:"login_streak"`
 		UltimoLogin       int64    `json:"last_login"`
 		QuestõesFeitas    []string `json:"feitas,omitempty"`
@@ -223,11 +268,15 @@ type UserDataFromToken struct {
 }
 
 func userInfo(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodPatch {
+		atualizarPerfil(w, r)
+		return
+	}
 	if r.Method != http.MethodOptions && r.Method != http.MethodGet {
 		w.WriteHeader(406)
 		return
 	}
-	userData := getUserData(r)
+	userData := getUserData(usuarioDoContexto(r).UUID)
 
 	if userData.Status != 200 {
 		enviarErrorJson(w, userData.Message, userData.Status)
@@ -258,44 +307,56 @@ type UserUUID struct {
 	Status  int
 	Message string
 	UUID    string
+	Familia string
+	Papel   Papel
 }
 
 func getUserUUID(r *http.Request) UserUUID {
-	authToken := r.Header.Get("Authorization")
-	if authToken == "" {
-		return UserUUID{Message: "Token faltando ou incorreta", Status: 400}
+	authToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || authToken == "" {
+		return UserUUID{Message: "Token faltando ou incorreta", Status: 401}
 	}
 
-	authToken = authToken[7:]
-
-	key, err := paseto.V4SymmetricKeyFromHex(os.Getenv("paseto_key"))
+	token, err := lerToken(authToken)
 	if err != nil {
-		logger.Println("[e] Erro de chave PASETO:", err)
-		return UserUUID{Message: "Erro de chave", Status: 500}
+		return UserUUID{Message: "Token faltando ou incorreta", Status: 401}
 	}
 
-	parseto := paseto.NewParser()
-	token, err := parseto.ParseV4Local(key, authToken, nil)
+	id, err := token.GetString("id")
 	if err != nil {
 		return UserUUID{Message: "Token faltando ou incorreta", Status: 401}
 	}
 
-	id, err := token.GetString("id")
+	familia, err := token.GetString("fam")
 	if err != nil {
-		return UserUUID{Message: "Token faltando ou incorreta", Status: 400}
+		return UserUUID{Message: "Token faltando ou incorreta", Status: 401}
 	}
 
-	return UserUUID{Status: 200, Message: "Usuário OK", UUID: id}
-}
+	papel, err := token.GetString("role")
+	if err != nil || !papelValido(Papel(papel)) {
+		return UserUUID{Message: "Token faltando ou incorreta", Status: 401}
+	}
 
-func getUserData(r *http.Request) UserDataFromToken {
-	id := getUserUUID(r)
-	if id.Status != 200 {
-		return UserDataFromToken{Message: id.Message, Status: id.Status}
+	emitido, err := token.GetIssuedAt()
+	if err != nil {
+		return UserUUID{Message: "Token faltando ou incorreta", Status: 401}
 	}
 
+	ativa, err := sessaoValida(id, familia, emitido)
+	if err != nil {
+		logger.Println("[e] Erro ao verificar sessão no Redis:", err)
+		return UserUUID{Message: "Algo não deu certo", Status: 500}
+	}
+	if !ativa {
+		return UserUUID{Message: "Sessão encerrada", Status: 401}
+	}
+
+	return UserUUID{Status: 200, Message: "Usuário OK", UUID: id, Familia: familia, Papel: Papel(papel)}
+}
+
+func getUserData(userID string) UserDataFromToken {
 	var userData UserData
-	userData.UUID = id.UUID
+	userData.UUID = userID
 
 	conn, err := OpenConn()
 	if err != nil {
@@ -306,20 +367,26 @@ func getUserData(r *http.Request) UserDataFromToken {
 
 	err = conn.QueryRow(`
     SELECT 
-        u.email, u.cpf, u.nome, u.telefone,
-        d.quest_feitas, d.alternativas_acertas, d.alternativas_erradas,
+        u.email, COALESCE(u.cpf_cifrado, ''), u.nome, u.telefone, u.verificado, u.papel, UNIX_TIMESTAMP(u.exclusao_agendada), u.totp_ativo,
+        d.quest_feitas, d.alternativas_acertas, d.alternativas_erradas, d.sequencia_acertos, d.xp,
         d.dias_logados, UNIX_TIMESTAMP(d.ultimo_login)
     FROM users u
     JOIN dados d ON u.id = d.id
     WHERE u.id = ?
-`, id.UUID).Scan(
+`, userID).Scan(
 		&userData.Email,
 		&userData.CPF,
 		&userData.Name,
 		&userData.Telephone,
+		&userData.Verified,
+		&userData.Role,
+		&userData.Exclusao,
+		&userData.TOTP,
 		&userData.Questões.Respondidas,
 		&userData.Questões.Acertos,
 		&userData.Questões.Erros,
+		&userData.Questões.Sequencia,
+		&userData.Questões.XP,
 		&userData.Questões.Dias,
 		&userData.Questões.UltimoLogin,
 	)
@@ -330,5 +397,14 @@ func getUserData(r *http.Request) UserDataFromToken {
 		return UserDataFromToken{Message: "Algo não deu certo", Status: 500}
 	}
 
+	// o CPF completo só sai por POST /user/cpf
+	cpf, err := decifrar(userData.CPF)
+	if err != nil {
+		logger.Printf("[e] Erro ao decifrar CPF de %v: %v\n", userID, err)
+		return UserDataFromToken{Message: "Algo não deu certo", Status: 500}
+	}
+	userData.CPF = mascararCPF(cpf)
+	userData.Completo = cpf != ""
+
 	return UserDataFromToken{User: userData, Message: "ok", Status: 200}
 }
@@ -2,15 +2,37 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"os"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
 )
 
 type MsgErro struct {
 	Erro     bool   `json:"erro"`
+	Codigo   string `json:"codigo,omitempty"`
 	Mensagem string `json:"mensagem"`
 }
 
+// Códigos de erro estáveis, para o front não depender do texto da mensagem.
+const (
+	codigoEmailNaoVerificado     = "email_nao_verificado"
+	codigoLoginBloqueado         = "login_bloqueado"
+	codigoSemPermissao           = "sem_permissao"
+	codigoSenhaFraca             = "senha_fraca"
+	codigoSenhaIncorreta         = "senha_incorreta"
+	codigoContaSemSenha          = "conta_sem_senha"
+	codigoReautenticacaoInvalida = "reautenticacao_invalida"
+	codigo2FAInvalido            = "codigo_2fa_invalido"
+	codigoPerfilIncompleto       = "perfil_incompleto"
+	codigoSemQuestoes            = "sem_questoes"
+	codigoQuestaoNaoRespondida   = "questao_nao_respondida"
+)
+
 func enviarErrorJson(w http.ResponseWriter, msg string, status int) {
 	s := MsgErro{
 		Erro:     true,
@@ -20,6 +42,16 @@ func enviarErrorJson(w http.ResponseWriter, msg string, status int) {
 	enviarRespostaJson(w, s, status)
 }
 
+func enviarErroCodigo(w http.ResponseWriter, codigo string, msg string, status int) {
+	s := MsgErro{
+		Erro:     true,
+		Codigo:   codigo,
+		Mensagem: msg,
+	}
+
+	enviarRespostaJson(w, s, status)
+}
+
 func enviarRespostaJson(w http.ResponseWriter, resposta any, status int) {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(status)
@@ -46,3 +78,30 @@ func corsMiddleware(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r)
 	})
 }
+
+// ipCliente devolve o IP de quem fez a requisição. O X-Forwarded-For só é
+// considerado se proxy_confiavel=true (servidor atrás de nginx, por exemplo), e
+// só a última entrada, que é a que o proxy acrescentou: as anteriores vêm do
+// cliente e podem ser qualquer coisa.
+func ipCliente(r *http.Request) string {
+	if os.Getenv("proxy_confiavel") == "true" {
+		if xff := strings.Join(r.Header.Values("X-Forwarded-For"), ","); xff != "" {
+			ip := xff[strings.LastIndex(xff, ",")+1:]
+			if ip = strings.TrimSpace(ip); ip != "" {
+				return ip
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// ehChaveDuplicada diz se o erro do MariaDB é de violação de UNIQUE.
+func ehChaveDuplicada(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 // ER_DUP_ENTRY
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Verificação de email: o registro manda um link com token de uso único e a
+// conta só pode responder perguntas depois que ele for aberto.
+
+const duracaoVerificacao = 48 * time.Hour
+
+func chaveVerificacao(hash string) string {
+	return fmt.Sprintf("verificacao:%s", hash)
+}
+
+func enviarVerificacao(userID, email string) error {
+	segredo, err := gerarSegredo()
+	if err != nil {
+		return err
+	}
+
+	chave := chaveVerificacao(hashSegredo(segredo))
+	pipe := rdb.TxPipeline()
+	pipe.Set(ctx, chave, userID, duracaoVerificacao)
+	indexarToken(pipe, userID, chave, duracaoVerificacao)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+
+	enviarEmail(Mensagem{
+		Para:    email,
+		Assunto: "Brain Quest - Confirme seu email",
+		Corpo: fmt.Sprintf("Bem-vindo ao Brain Quest!\n\n"+
+			"Para confirmar seu email, acesse:\n%s\n\n"+
+			"O link vale por %v horas.\n",
+			linkFrontend("/verify?token=%s", segredo), duracaoVerificacao.Hours()),
+	})
+	return nil
+}
+
+func verificarEmail(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodGet {
+		w.WriteHeader(406)
+		return
+	}
+	token := r.PathValue("token")
+	if token == "" {
+		enviarErrorJson(w, "Token de verificação vazio", 400)
+		return
+	}
+
+	uuidUsuario, err := rdb.GetDel(ctx, chaveVerificacao(hashSegredo(token))).Result()
+	if err == redis.Nil {
+		enviarErrorJson(w, "Token de verificação inválido ou expirado", 401)
+		return
+	} else if err != nil {
+		logger.Println("[e] Erro ao buscar token de verificação:", err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	if _, err := conn.Exec("UPDATE users SET verificado = TRUE WHERE id = ?", uuidUsuario); err != nil {
+		logger.Printf("[e] Erro ao verificar email de %v: %v\n", uuidUsuario, err)
+		enviarErrorJson(w, "Algo deu errado", 500)
+		return
+	}
+	invalidarCacheUsuario(uuidUsuario)
+
+	enviarRespostaJson(w, "ok", 200)
+}
+
+type ReenviarVerificacaoData struct {
+	Email string `json:"email"`
+}
+
+func reenviarVerificacao(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodOptions && r.Method != http.MethodPost {
+		w.WriteHeader(406)
+		return
+	}
+	var dados ReenviarVerificacaoData
+
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	err := d.Decode(&dados)
+
+	if err != nil || d.More() {
+		enviarErrorJson(w, "Estrutura do JSON incorreta.", 400)
+		return
+	}
+
+	if _, err := mail.ParseAddress(dados.Email); err != nil {
+		enviarErrorJson(w, "Email inválido", 400)
+		return
+	}
+
+	conn, err := OpenConn()
+	if err != nil {
+		enviarErrorJson(w, "Erro ao conectar ao banco", 504)
+		return
+	}
+	defer conn.Close()
+
+	// a resposta é sempre a mesma, exista o e-mail ou não
+	var uuidUsuario string
+	err = conn.QueryRow("SELECT id FROM users WHERE email = ? AND NOT verificado", dados.Email).Scan(&uuidUsuario)
+	if err == nil {
+		if err := enviarVerificacao(uuidUsuario, dados.Email); err != nil {
+			logger.Printf("[e] Erro ao reenviar verificação para %v: %v\n", uuidUsuario, err)
+		}
+	}
+
+	enviarRespostaJson(w, "ok", 200)
+}